@@ -0,0 +1,287 @@
+/*
+ * Copyright 2026 Uber Technologies, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *         http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package h3
+
+/*
+#include <stdlib.h>
+#include <h3_h3api.h>
+#include <h3_bbox.h>
+#include <h3_polygon.h>
+#include <h3_polyfill.h>
+*/
+import "C"
+
+import (
+	"math"
+	"unsafe"
+)
+
+// maxBBoxPieceWidth is the widest span of longitude, in degrees, passed to
+// the polygon fill at once. Wider loops would be read as crossing the
+// antimeridian.
+const maxBBoxPieceWidth = 90
+
+// BBox is a geographic bounding box with coordinates in degrees.
+//
+// A box whose East is less than its West crosses the antimeridian.
+type BBox struct {
+	North, South, East, West float64
+}
+
+// NewBBox is a helper function to create a BBox.
+func NewBBox(north, south, east, west float64) BBox {
+	return BBox{North: north, South: south, East: east, West: west}
+}
+
+// BBox returns the bounding box of the loop. Arcs spanning more than 180
+// degrees of longitude are interpreted as crossing the antimeridian.
+//
+// Loops containing a pole are not supported.
+func (l GeoLoop) BBox() BBox {
+	if len(l) == 0 {
+		return BBox{}
+	}
+
+	cloop := allocCGeoLoop(l)
+	defer freeCGeoLoop(&cloop)
+
+	var out C.BBox
+	C.bboxFromGeoLoop(&cloop, &out)
+
+	return bboxFromC(out)
+}
+
+// BBox returns the bounding box of the outer loop of the polygon.
+func (p GeoPolygon) BBox() BBox {
+	return p.GeoLoop.BBox()
+}
+
+// BBox returns the bounding box of the cell boundary.
+func (b CellBoundary) BBox() BBox {
+	return GeoLoop(b).BBox()
+}
+
+// CellToBBox returns the bounding box of the cell. If coverChildren is true,
+// the box is expanded to cover the boundaries of all of the cell's
+// descendants, which may extend slightly past the cell itself.
+func CellToBBox(c Cell, coverChildren bool) (BBox, error) {
+	var out C.BBox
+
+	errC := C.cellToBBox(C.H3Index(c), &out, C.bool(coverChildren))
+
+	return bboxFromC(out), toOpErr(errC, "CellToBBox", c, coverChildren)
+}
+
+// BBox returns the bounding box of the cell. If coverChildren is true, the
+// box is expanded to cover the boundaries of all of the cell's descendants,
+// which may extend slightly past the cell itself.
+func (c Cell) BBox(coverChildren bool) (BBox, error) {
+	return CellToBBox(c, coverChildren)
+}
+
+// IsTransmeridian returns whether the bounding box crosses the antimeridian.
+func (b BBox) IsTransmeridian() bool {
+	cb := b.toC()
+	return bool(C.bboxIsTransmeridian(&cb))
+}
+
+// WidthRads returns the width of the bounding box in radians.
+func (b BBox) WidthRads() float64 {
+	cb := b.toC()
+	return float64(C.bboxWidthRads(&cb))
+}
+
+// HeightRads returns the height of the bounding box in radians.
+func (b BBox) HeightRads() float64 {
+	cb := b.toC()
+	return float64(C.bboxHeightRads(&cb))
+}
+
+// Center returns the center of the bounding box, accounting for boxes that
+// cross the antimeridian.
+func (b BBox) Center() LatLng {
+	var out C.LatLng
+
+	cb := b.toC()
+	C.bboxCenter(&cb, &out)
+
+	return latLngFromC(out)
+}
+
+// Contains returns whether the point is inside the bounding box, inclusive of
+// its edges.
+func (b BBox) Contains(point LatLng) bool {
+	cb, cp := b.toC(), point.toC()
+	return bool(C.bboxContains(&cb, &cp))
+}
+
+// ContainsBBox returns whether the other bounding box is entirely inside this
+// bounding box.
+func (b BBox) ContainsBBox(other BBox) bool {
+	ca, cb := b.toC(), other.toC()
+	return bool(C.bboxContainsBBox(&ca, &cb))
+}
+
+// Overlaps returns whether the two bounding boxes overlap.
+func (b BBox) Overlaps(other BBox) bool {
+	ca, cb := b.toC(), other.toC()
+	return bool(C.bboxOverlapsBBox(&ca, &cb))
+}
+
+// Scale returns the bounding box with its width and height multiplied by scale
+// around its center. Latitudes are clamped to the poles and longitudes are
+// wrapped around the antimeridian.
+func (b BBox) Scale(scale float64) BBox {
+	cb := b.toC()
+	C.scaleBBox(&cb, C.double(scale))
+
+	return bboxFromC(cb)
+}
+
+// BBoxToCells returns the cells at resolution in the bounding box, such as a
+// map viewport, selected by mode like PolygonToCellsExperimental. Coordinates
+// are in degrees.
+//
+// A box whose east is less than its west crosses the antimeridian, and one
+// from -180 to 180 spans all longitudes. Boxes may touch the poles. Wide boxes
+// are split internally into pieces the polygon fill handles, with edges along
+// lines of constant latitude and longitude; ContainmentFull is then applied to
+// the box as a whole, so cells straddling a split are kept.
+//
+// ErrLatLngDomain is returned if a latitude is outside [-90, 90], a longitude
+// is outside [-180, 180] or south is greater than north.
+func BBoxToCells(south, west, north, east float64, resolution int, mode ContainmentMode) ([]Cell, error) {
+	if !(south >= -90 && north <= 90 && south <= north && math.Abs(west) <= 180 && math.Abs(east) <= 180) { //nolint:mnd // degree bounds
+		return nil, toOpErr(C.E_LATLNG_DOMAIN, "BBoxToCells", south, west, north, east)
+	}
+
+	if west == east || south == north {
+		return nil, nil
+	}
+
+	// Split the box at the antimeridian, then into pieces narrow enough for
+	// the polygon fill.
+	spans := [][2]float64{{west, east}}
+	if west > east {
+		spans = [][2]float64{{west, 180}, {-180, east}} //nolint:mnd // antimeridian
+	}
+
+	fillMode := mode
+	if mode == ContainmentFull && (len(spans) > 1 || east-west > maxBBoxPieceWidth) {
+		fillMode = ContainmentOverlapping
+	}
+
+	out := make(CellSet)
+	for _, span := range spans {
+		width := span[1] - span[0]
+		pieces := int(math.Ceil(width / maxBBoxPieceWidth))
+
+		for i := range pieces {
+			w := span[0] + width*float64(i)/float64(pieces)
+			e := span[0] + width*float64(i+1)/float64(pieces)
+
+			piece := GeoPolygon{GeoLoop: GeoLoop{
+				{Lat: south, Lng: w},
+				{Lat: south, Lng: e},
+				{Lat: north, Lng: e},
+				{Lat: north, Lng: w},
+			}}
+
+			cells, err := PolygonToCellsExperimental(piece, resolution, fillMode)
+			if err != nil {
+				return nil, err
+			}
+			out.Add(cells...)
+		}
+	}
+
+	// A piece with a vertex at a pole can miss the cell containing the pole,
+	// which overlaps every box that reaches it.
+	if fillMode == ContainmentOverlapping || fillMode == ContainmentOverlappingBbox {
+		for _, pole := range []float64{south, north} {
+			if math.Abs(pole) != 90 { //nolint:mnd // poles
+				continue
+			}
+
+			c, err := LatLngToCell(LatLng{Lat: pole}, resolution)
+			if err != nil {
+				return nil, err
+			}
+			out.Add(c)
+		}
+	}
+
+	if fillMode == mode {
+		return out.Cells(), nil
+	}
+
+	b := NewBBox(north, south, east, west)
+	for c := range out.All() {
+		boundary, err := c.Boundary()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range boundary {
+			if !b.Contains(v) {
+				out.Remove(c)
+				break
+			}
+		}
+	}
+
+	return out.Cells(), nil
+}
+
+// Cells returns the cells at resolution in the bounding box, selected by mode.
+// See BBoxToCells.
+func (b BBox) Cells(resolution int, mode ContainmentMode) ([]Cell, error) {
+	return BBoxToCells(b.South, b.West, b.North, b.East, resolution, mode)
+}
+
+func (b BBox) toC() C.BBox {
+	return C.BBox{
+		north: C.double(DegsToRads * b.North),
+		south: C.double(DegsToRads * b.South),
+		east:  C.double(DegsToRads * b.East),
+		west:  C.double(DegsToRads * b.West),
+	}
+}
+
+func bboxFromC(cb C.BBox) BBox {
+	return BBox{
+		North: RadsToDegs * float64(cb.north),
+		South: RadsToDegs * float64(cb.south),
+		East:  RadsToDegs * float64(cb.east),
+		West:  RadsToDegs * float64(cb.west),
+	}
+}
+
+// Convert GeoLoop to C equivalent struct. The caller must free the verts
+// pointer with freeCGeoLoop.
+func allocCGeoLoop(l GeoLoop) C.GeoLoop {
+	return C.GeoLoop{
+		numVerts: C.int(len(l)),
+		verts:    latLngsToC(l),
+	}
+}
+
+// Free pointer values on a C GeoLoop struct
+func freeCGeoLoop(cl *C.GeoLoop) {
+	C.free(unsafe.Pointer(cl.verts))
+	cl.verts = nil
+}
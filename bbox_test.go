@@ -0,0 +1,268 @@
+/*
+ * Copyright 2026 Uber Technologies, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *         http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package h3
+
+import "testing"
+
+var transmeridianGeoLoop = GeoLoop{
+	{Lat: 10, Lng: 170},
+	{Lat: 10, Lng: -170},
+	{Lat: -10, Lng: -170},
+	{Lat: -10, Lng: 170},
+}
+
+func TestGeoLoop_BBox(t *testing.T) {
+	t.Parallel()
+
+	t.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		b := validGeoLoop.BBox()
+		assertEqualEps(t, 67.234563187, b.North)
+		assertEqualEps(t, 67.067252558, b.South)
+		assertEqualEps(t, -168.154801171, b.East)
+		assertEqualEps(t, -168.626914333, b.West)
+		assertFalse(t, b.IsTransmeridian())
+
+		assertEqual(t, b, validGeoPolygonHoles.BBox())
+	})
+
+	t.Run("transmeridian", func(t *testing.T) {
+		t.Parallel()
+
+		b := transmeridianGeoLoop.BBox()
+		assertEqual(t, NewBBox(10, -10, -170, 170), b)
+		assertTrue(t, b.IsTransmeridian())
+		assertEqualEps(t, 20*DegsToRads, b.WidthRads())
+		assertEqualEps(t, 20*DegsToRads, b.HeightRads())
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		t.Parallel()
+		assertEqual(t, BBox{}, GeoLoop{}.BBox())
+	})
+}
+
+func TestCellToBBox(t *testing.T) {
+	t.Parallel()
+
+	boundary, _ := validCell.Boundary()
+	expected := boundary.BBox()
+
+	b, err := validCell.BBox(false)
+	assertNoErr(t, err)
+	assertTrue(t, b.ContainsBBox(expected))
+
+	children, err := CellToBBox(validCell, true)
+	assertNoErr(t, err)
+	assertTrue(t, children.ContainsBBox(b))
+
+	for _, ll := range boundary {
+		assertTrue(t, b.Contains(ll))
+	}
+
+	_, err = Cell(-1).BBox(false)
+	assertErrIs(t, err, ErrCellInvalid)
+}
+
+func TestBBox_Contains(t *testing.T) {
+	t.Parallel()
+
+	b := transmeridianGeoLoop.BBox()
+	assertTrue(t, b.Contains(NewLatLng(0, 180)))
+	assertTrue(t, b.Contains(NewLatLng(0, 175)))
+	assertTrue(t, b.Contains(NewLatLng(0, -175)))
+	assertFalse(t, b.Contains(NewLatLng(0, 0)))
+	assertFalse(t, b.Contains(NewLatLng(20, 175)))
+
+	assertTrue(t, b.ContainsBBox(NewBBox(5, -5, -175, 175)))
+	assertFalse(t, b.ContainsBBox(NewBBox(5, -5, 175, -175)))
+}
+
+func TestBBox_Overlaps(t *testing.T) {
+	t.Parallel()
+
+	b := transmeridianGeoLoop.BBox()
+	assertTrue(t, b.Overlaps(NewBBox(5, -5, -160, -175)))
+	assertTrue(t, b.Overlaps(NewBBox(5, -5, 175, 160)))
+	assertFalse(t, b.Overlaps(NewBBox(5, -5, 10, -10)))
+	assertFalse(t, b.Overlaps(NewBBox(30, 20, -175, 175)))
+}
+
+func TestBBox_Center(t *testing.T) {
+	t.Parallel()
+
+	assertEqualLatLng(t, NewLatLng(0, 180), transmeridianGeoLoop.BBox().Center())
+	assertEqualLatLng(t, NewLatLng(5, 15), NewBBox(10, 0, 20, 10).Center())
+}
+
+func TestBBox_Scale(t *testing.T) {
+	t.Parallel()
+
+	b := NewBBox(10, 0, 20, 10).Scale(2)
+	assertEqualEps(t, 15, b.North)
+	assertEqualEps(t, -5, b.South)
+	assertEqualEps(t, 25, b.East)
+	assertEqualEps(t, 5, b.West)
+
+	b = transmeridianGeoLoop.BBox().Scale(2)
+	assertEqualEps(t, -160, b.East)
+	assertEqualEps(t, 160, b.West)
+}
+
+func TestBBoxToCells(t *testing.T) {
+	t.Parallel()
+
+	t.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		polygon := GeoPolygon{GeoLoop: GeoLoop{
+			{Lat: 37.7, Lng: -122.5},
+			{Lat: 37.7, Lng: -122.3},
+			{Lat: 37.8, Lng: -122.3},
+			{Lat: 37.8, Lng: -122.5},
+		}}
+
+		for _, mode := range []ContainmentMode{ContainmentCenter, ContainmentFull, ContainmentOverlapping, ContainmentOverlappingBbox} {
+			expected, err := PolygonToCellsExperimental(polygon, 8, mode)
+			assertNoErr(t, err)
+
+			cells, err := BBoxToCells(37.7, -122.5, 37.8, -122.3, 8, mode)
+			assertNoErr(t, err)
+			assertEqualCells(t, NewCellSet(expected...).Cells(), cells)
+
+			cells, err = polygon.BBox().Cells(8, mode)
+			assertNoErr(t, err)
+			assertEqualCells(t, NewCellSet(expected...).Cells(), cells)
+		}
+	})
+
+	t.Run("transmeridian", func(t *testing.T) {
+		t.Parallel()
+
+		b := transmeridianGeoLoop.BBox()
+
+		center, err := BBoxToCells(b.South, b.West, b.North, b.East, 3, ContainmentCenter)
+		assertNoErr(t, err)
+		assertTrue(t, len(center) > 0)
+		for _, c := range center {
+			ll, _ := c.LatLng()
+			assertTrue(t, b.Contains(ll))
+		}
+
+		full, err := BBoxToCells(b.South, b.West, b.North, b.East, 3, ContainmentFull)
+		assertNoErr(t, err)
+		assertEqual(t, 0, NewCellSet(full...).Difference(NewCellSet(center...)).Len())
+
+		// Cells straddling the antimeridian are kept.
+		straddling := 0
+		for _, c := range full {
+			boundary, _ := c.Boundary()
+			east, west := false, false
+			for _, v := range boundary {
+				assertTrue(t, b.Contains(v))
+				east = east || v.Lng > 0
+				west = west || v.Lng < 0
+			}
+			if east && west {
+				straddling++
+			}
+		}
+		assertTrue(t, straddling > 0)
+
+		overlapping, err := BBoxToCells(b.South, b.West, b.North, b.East, 3, ContainmentOverlapping)
+		assertNoErr(t, err)
+		assertEqual(t, 0, NewCellSet(center...).Difference(NewCellSet(overlapping...)).Len())
+	})
+
+	t.Run("pole", func(t *testing.T) {
+		t.Parallel()
+
+		pole, err := LatLngToCell(NewLatLng(90, 0), 2)
+		assertNoErr(t, err)
+
+		res0, err := Res0Cells()
+		assertNoErr(t, err)
+
+		expected := NewCellSet()
+		for _, base := range res0 {
+			children, _ := base.Children(2)
+			for _, c := range children {
+				ll, _ := c.LatLng()
+				if ll.Lat >= 80 {
+					expected.Add(c)
+				}
+			}
+		}
+
+		cells, err := BBoxToCells(80, -180, 90, 180, 2, ContainmentCenter)
+		assertNoErr(t, err)
+		assertEqualCells(t, expected.Cells(), cells)
+
+		cells, err = BBoxToCells(80, -180, 90, 180, 2, ContainmentFull)
+		assertNoErr(t, err)
+		assertTrue(t, NewCellSet(cells...).Contains(pole))
+
+		southPole, err := LatLngToCell(NewLatLng(-90, 0), 2)
+		assertNoErr(t, err)
+
+		// Boxes crossing the antimeridian at a pole overlap the pole cell, like
+		// boxes on either side of it.
+		for _, b := range []BBox{
+			{North: 90, South: 80, East: -170, West: 170},
+			{North: 90, South: 60, East: -179, West: 179},
+			{North: 90, South: 80, East: 20, West: 0},
+		} {
+			for _, mode := range []ContainmentMode{ContainmentOverlapping, ContainmentOverlappingBbox} {
+				cells, err := b.Cells(2, mode)
+				assertNoErr(t, err)
+				assertTrue(t, NewCellSet(cells...).Contains(pole))
+			}
+		}
+
+		cells, err = BBoxToCells(-90, 170, -80, -170, 2, ContainmentOverlapping)
+		assertNoErr(t, err)
+		assertTrue(t, NewCellSet(cells...).Contains(southPole))
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		t.Parallel()
+
+		cells, err := BBoxToCells(10, 20, 10, 30, 5, ContainmentCenter)
+		assertNoErr(t, err)
+		assertEqual(t, 0, len(cells))
+
+		cells, err = BBoxToCells(10, 20, 20, 20, 5, ContainmentCenter)
+		assertNoErr(t, err)
+		assertEqual(t, 0, len(cells))
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := BBoxToCells(20, 0, 10, 10, 5, ContainmentCenter)
+		assertErrIs(t, err, ErrLatLngDomain)
+		_, err = BBoxToCells(-91, 0, 10, 10, 5, ContainmentCenter)
+		assertErrIs(t, err, ErrLatLngDomain)
+		_, err = BBoxToCells(0, 0, 10, 181, 5, ContainmentCenter)
+		assertErrIs(t, err, ErrLatLngDomain)
+		_, err = BBoxToCells(0, 0, 10, 10, MaxResolution+1, ContainmentCenter)
+		assertErrIs(t, err, ErrResolutionDomain)
+		_, err = BBoxToCells(0, 0, 10, 10, 5, ContainmentInvalid)
+		assertErrIs(t, err, ErrOptionInvalid)
+	})
+}
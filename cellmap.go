@@ -0,0 +1,258 @@
+/*
+ * Copyright 2026 Uber Technologies, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *         http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package h3
+
+/*
+#include <h3_h3api.h>
+*/
+import "C"
+
+import (
+	"cmp"
+	"maps"
+	"slices"
+)
+
+type (
+	// CellMap maps cells, which may be of mixed resolutions, to values.
+	CellMap[V any] map[Cell]V
+
+	// Reducer combines the values of the cells grouped under parent by
+	// CellMap.RollUp into the value of parent. values is never empty and is
+	// ordered by the index of the cells it came from.
+	Reducer[V any] func(parent Cell, values []V) V
+
+	// Splitter distributes the value of parent over its descendants for
+	// CellMap.DrillDown. It returns one value for each cell in children, in the
+	// same order.
+	Splitter[V any] func(parent Cell, value V, children []Cell) ([]V, error)
+
+	// Number is the constraint for values the built-in reducers and splitters
+	// do arithmetic on.
+	Number interface {
+		~int | ~int8 | ~int16 | ~int32 | ~int64 |
+			~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+			~float32 | ~float64
+	}
+)
+
+// RollUp returns the values grouped under their ancestors at resolution,
+// combined with reduce. Cells already at resolution form a group of their
+// own. ErrRsolutionMismatch is returned if the map holds cells coarser than
+// resolution.
+func (m CellMap[V]) RollUp(resolution int, reduce Reducer[V]) (CellMap[V], error) {
+	groups := make(map[Cell][]V)
+
+	for _, c := range slices.Sorted(maps.Keys(m)) {
+		parent, err := c.Parent(resolution)
+		if err != nil {
+			return nil, err
+		}
+		groups[parent] = append(groups[parent], m[c])
+	}
+
+	out := make(CellMap[V], len(groups))
+	for parent, values := range groups {
+		out[parent] = reduce(parent, values)
+	}
+
+	return out, nil
+}
+
+// DrillDown returns the values distributed over the descendants of their cells
+// at resolution with split. Cells already at resolution keep their value.
+//
+// ErrRsolutionMismatch is returned if the map holds cells finer than
+// resolution, and ErrDuplicateInput if it holds both a cell and one of its
+// ancestors, since both would assign a value to the same descendant.
+func (m CellMap[V]) DrillDown(resolution int, split Splitter[V]) (CellMap[V], error) {
+	out := make(CellMap[V], len(m))
+
+	for _, c := range slices.Sorted(maps.Keys(m)) {
+		if c.Resolution() > resolution {
+			return nil, toOpErr(C.E_RES_MISMATCH, "CellMap.DrillDown", c, resolution)
+		}
+
+		children, err := c.Children(resolution)
+		if err != nil {
+			return nil, err
+		}
+
+		values := []V{m[c]}
+		if c.Resolution() < resolution {
+			values, err = split(c, m[c], children)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if len(values) != len(children) {
+			return nil, toOpErr(C.E_FAILED, "CellMap.DrillDown", c, resolution)
+		}
+
+		for i, child := range children {
+			if _, ok := out[child]; ok {
+				return nil, toOpErr(C.E_DUPLICATE_INPUT, "CellMap.DrillDown", child, resolution)
+			}
+			out[child] = values[i]
+		}
+	}
+
+	return out, nil
+}
+
+// ReduceSum returns a Reducer adding the values together.
+func ReduceSum[V Number]() Reducer[V] {
+	return func(_ Cell, values []V) V {
+		var sum V
+		for _, v := range values {
+			sum += v
+		}
+
+		return sum
+	}
+}
+
+// ReduceMean returns a Reducer averaging the values. Integer means are
+// truncated. The mean is over the cells present, not over all descendants of
+// the parent.
+//
+// The sum is taken in float64, int64 or uint64, so the mean is correct for
+// narrow types such as uint8 even when the sum or the number of values does
+// not fit in V.
+func ReduceMean[V Number]() Reducer[V] {
+	return func(_ Cell, values []V) V {
+		switch {
+		case isFloat[V]():
+			var sum float64
+			for _, v := range values {
+				sum += float64(v)
+			}
+
+			return V(sum / float64(len(values)))
+
+		case isSigned[V]():
+			var sum int64
+			for _, v := range values {
+				sum += int64(v)
+			}
+
+			return V(sum / int64(len(values)))
+
+		default:
+			var sum uint64
+			for _, v := range values {
+				sum += uint64(v)
+			}
+
+			return V(sum / uint64(len(values)))
+		}
+	}
+}
+
+// ReduceMin returns a Reducer taking the smallest value.
+func ReduceMin[V cmp.Ordered]() Reducer[V] {
+	return func(_ Cell, values []V) V {
+		return slices.Min(values)
+	}
+}
+
+// ReduceMax returns a Reducer taking the largest value.
+func ReduceMax[V cmp.Ordered]() Reducer[V] {
+	return func(_ Cell, values []V) V {
+		return slices.Max(values)
+	}
+}
+
+// SplitEven returns a Splitter dividing the value equally between the
+// children, preserving the total. For integer values, the remainder is spread
+// one unit at a time over the first children, so children may get 0 when
+// there are more children than units.
+func SplitEven[V Number]() Splitter[V] {
+	return func(_ Cell, value V, children []Cell) ([]V, error) {
+		out := make([]V, len(children))
+
+		// The number of children may not fit in V, so divide in a wider type.
+		var (
+			share     V
+			remainder int64
+		)
+		switch {
+		case isFloat[V]():
+			share = V(float64(value) / float64(len(children)))
+		case isSigned[V]():
+			n := int64(len(children))
+			share, remainder = V(int64(value)/n), int64(value)%n
+		default:
+			n := uint64(len(children))
+			share, remainder = V(uint64(value)/n), int64(uint64(value)%n)
+		}
+
+		for i := range out {
+			out[i] = share
+		}
+
+		// Integer division leaves a remainder smaller than n in magnitude.
+		for i := 0; remainder > 0; i++ {
+			out[i]++
+			remainder--
+		}
+		for i := 0; remainder < 0; i++ {
+			out[i]--
+			remainder++
+		}
+
+		return out, nil
+	}
+}
+
+// SplitByArea returns a Splitter dividing the value between the children in
+// proportion to their exact area, preserving the total. Areas differ slightly
+// between the children of a cell, and more so for pentagons.
+func SplitByArea[V ~float32 | ~float64]() Splitter[V] {
+	return func(_ Cell, value V, children []Cell) ([]V, error) {
+		areas := make([]float64, len(children))
+
+		var total float64
+		for i, c := range children {
+			area, err := CellAreaM2(c)
+			if err != nil {
+				return nil, err
+			}
+			areas[i] = area
+			total += area
+		}
+
+		out := make([]V, len(children))
+		for i, area := range areas {
+			out[i] = V(float64(value) * area / total)
+		}
+
+		return out, nil
+	}
+}
+
+// isFloat reports whether V is a floating-point type.
+func isFloat[V Number]() bool {
+	return V(1)/V(2) != 0 //nolint:mnd // integer division truncates to 0
+}
+
+// isSigned reports whether V is a signed integer or floating-point type.
+func isSigned[V Number]() bool {
+	var zero V
+	return zero-1 < zero
+}
@@ -0,0 +1,205 @@
+/*
+ * Copyright 2026 Uber Technologies, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *         http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package h3
+
+import (
+	"errors"
+	"maps"
+	"slices"
+	"testing"
+)
+
+func TestCellMap_RollUp(t *testing.T) {
+	t.Parallel()
+
+	children, _ := validCell.ImmediateChildren()
+	other, _ := pentagonCell.CenterChild(validCell.Resolution() + 1)
+	otherParent, _ := other.ImmediateParent()
+
+	m := CellMap[int]{other: 10}
+	for i, c := range children {
+		m[c] = i + 1 // 1..7
+	}
+
+	testCases := []struct {
+		name     string
+		reduce   Reducer[int]
+		expected int
+	}{
+		{"sum", ReduceSum[int](), 28},
+		{"mean", ReduceMean[int](), 4},
+		{"min", ReduceMin[int](), 1},
+		{"max", ReduceMax[int](), 7},
+		{"custom", func(_ Cell, values []int) int { return values[0] }, m[min(children[0], children[6])]},
+	}
+
+	for _, tc := range testCases {
+		out, err := m.RollUp(validCell.Resolution(), tc.reduce)
+		assertNoErr(t, err)
+		assertEqual(t, 2, len(out), tc.name)
+		assertEqual(t, tc.expected, out[validCell], tc.name)
+		assertEqual(t, 10, out[otherParent], tc.name)
+	}
+
+	// Already at the resolution.
+	out, err := m.RollUp(validCell.Resolution()+1, ReduceSum[int]())
+	assertNoErr(t, err)
+	assertEqual(t, len(m), len(out))
+
+	_, err = CellMap[int]{validCell: 1}.RollUp(validCell.Resolution()+1, ReduceSum[int]())
+	assertErrIs(t, err, ErrRsolutionMismatch)
+
+	means, err := CellMap[float64]{children[0]: 1, children[1]: 2}.RollUp(validCell.Resolution(), ReduceMean[float64]())
+	assertNoErr(t, err)
+	assertEqualEps(t, 1.5, means[validCell])
+}
+
+func TestCellMap_DrillDown(t *testing.T) {
+	t.Parallel()
+
+	t.Run("even", func(t *testing.T) {
+		t.Parallel()
+
+		pentagon, _ := pentagonCell.CenterChild(validCell.Resolution())
+		m := CellMap[int]{validCell: 100, pentagon: -8}
+		out, err := m.DrillDown(validCell.Resolution()+1, SplitEven[int]())
+		assertNoErr(t, err)
+		assertEqual(t, 7+6, len(out))
+
+		totals, err := out.RollUp(validCell.Resolution(), ReduceSum[int]())
+		assertNoErr(t, err)
+		assertEqual(t, 100, totals[validCell])
+		assertEqual(t, -8, totals[pentagon])
+
+		children, _ := validCell.Children(validCell.Resolution() + 1)
+		for _, c := range children {
+			assertTrue(t, out[c] == 14 || out[c] == 15)
+		}
+	})
+
+	t.Run("area", func(t *testing.T) {
+		t.Parallel()
+
+		res := pentagonCell.Resolution() + 2
+		out, err := CellMap[float64]{pentagonCell: 1}.DrillDown(res, SplitByArea[float64]())
+		assertNoErr(t, err)
+
+		children, _ := pentagonCell.Children(res)
+		assertEqual(t, len(children), len(out))
+
+		total, err := out.RollUp(pentagonCell.Resolution(), ReduceSum[float64]())
+		assertNoErr(t, err)
+		assertEqualEps(t, 1, total[pentagonCell])
+
+		// Larger children receive larger shares.
+		parentArea, _ := CellAreaM2(pentagonCell)
+		for _, c := range children {
+			area, _ := CellAreaM2(c)
+			assertEqualEps(t, area/parentArea, out[c])
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		t.Parallel()
+
+		child, _ := validCell.CenterChild(validCell.Resolution() + 1)
+
+		_, err := CellMap[int]{child: 1}.DrillDown(validCell.Resolution(), SplitEven[int]())
+		assertErrIs(t, err, ErrRsolutionMismatch)
+
+		_, err = CellMap[int]{child: 1, validCell: 1}.DrillDown(child.Resolution(), SplitEven[int]())
+		assertErrIs(t, err, ErrDuplicateInput)
+
+		errSplit := errors.New("split")
+		_, err = CellMap[int]{validCell: 1}.DrillDown(child.Resolution(), func(Cell, int, []Cell) ([]int, error) {
+			return nil, errSplit
+		})
+		assertErrIs(t, err, errSplit)
+
+		_, err = CellMap[int]{validCell: 1}.DrillDown(child.Resolution(), func(Cell, int, []Cell) ([]int, error) {
+			return []int{1}, nil
+		})
+		assertErrIs(t, err, ErrFailed)
+	})
+}
+
+func TestSplitEven(t *testing.T) {
+	t.Parallel()
+
+	cells := make([]Cell, 7)
+
+	ints, _ := SplitEven[int]()(validCell, -10, cells)
+	assertEqual(t, -2, ints[0])
+	assertEqual(t, -2, ints[2])
+	assertEqual(t, -1, ints[3])
+
+	uints, _ := SplitEven[uint8]()(validCell, 10, cells)
+	assertEqual(t, uint8(2), uints[2])
+	assertEqual(t, uint8(1), uints[3])
+
+	floats, _ := SplitEven[float64]()(validCell, 7, cells)
+	assertEqualEps(t, 1, floats[6])
+
+	// More children than a uint8 or int8 can count.
+	children := make([]Cell, 343)
+
+	narrow, err := SplitEven[uint8]()(validCell, 200, children)
+	assertNoErr(t, err)
+	assertEqual(t, 200, sumInts(narrow))
+	assertEqual(t, uint8(1), narrow[199])
+	assertEqual(t, uint8(0), narrow[200])
+
+	signed, err := SplitEven[int8]()(validCell, -100, children)
+	assertNoErr(t, err)
+	assertEqual(t, -100, sumInts(signed))
+
+	m := CellMap[uint8]{validCell: 200}
+	out, err := m.DrillDown(validCell.Resolution()+3, SplitEven[uint8]())
+	assertNoErr(t, err)
+	assertEqual(t, 343, len(out))
+	assertEqual(t, 200, sumInts(slices.Collect(maps.Values(out))))
+}
+
+func TestReduceMean(t *testing.T) {
+	t.Parallel()
+
+	// The sum and the number of values overflow uint8 and int8.
+	uints := make([]uint8, 256)
+	for i := range uints {
+		uints[i] = 200
+	}
+	assertEqual(t, uint8(200), ReduceMean[uint8]()(validCell, uints))
+
+	ints := make([]int8, 300)
+	for i := range ints {
+		ints[i] = int8(-100 + i%2)
+	}
+	assertEqual(t, int8(-99), ReduceMean[int8]()(validCell, ints))
+
+	floats := []float32{1, 2}
+	assertEqualEps(t, 1.5, float64(ReduceMean[float32]()(validCell, floats)))
+}
+
+// sumInts adds integer values without overflowing narrow types.
+func sumInts[V ~int8 | ~uint8](values []V) int {
+	var sum int
+	for _, v := range values {
+		sum += int(v)
+	}
+
+	return sum
+}
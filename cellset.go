@@ -0,0 +1,310 @@
+/*
+ * Copyright 2026 Uber Technologies, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *         http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package h3
+
+import (
+	"iter"
+	"maps"
+	"slices"
+)
+
+// CellSet is a set of cells, which may be of mixed resolutions.
+//
+// Add, Remove and Contains operate on the cells themselves. The set algebra
+// methods instead treat the set as the area covered by its cells, so the
+// intersection of a set holding a cell with a set holding one of its children
+// is the child. Their results never hold both a cell and one of its
+// descendants.
+//
+// A CellSet is a map and must be created with NewCellSet or make before
+// cells are added.
+type CellSet map[Cell]struct{}
+
+// NewCellSet returns a set holding the given cells.
+func NewCellSet(cells ...Cell) CellSet {
+	s := make(CellSet, len(cells))
+	s.Add(cells...)
+
+	return s
+}
+
+// PolygonToCellSet returns the cells PolygonToCells fills the polygon with,
+// as a set.
+func PolygonToCellSet(polygon GeoPolygon, resolution int) (CellSet, error) {
+	cells, err := PolygonToCells(polygon, resolution)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewCellSet(cells...), nil
+}
+
+// Add adds the cells to the set.
+func (s CellSet) Add(cells ...Cell) {
+	for _, c := range cells {
+		s[c] = struct{}{}
+	}
+}
+
+// Remove removes the cells from the set. Cells not in the set are ignored.
+func (s CellSet) Remove(cells ...Cell) {
+	for _, c := range cells {
+		delete(s, c)
+	}
+}
+
+// Contains returns whether the cell is in the set.
+func (s CellSet) Contains(c Cell) bool {
+	_, ok := s[c]
+	return ok
+}
+
+// Covers returns whether the cell or one of its ancestors is in the set.
+//
+// A cell whose area is covered only by its descendants is not reported as
+// covered unless the set has been compacted.
+func (s CellSet) Covers(c Cell) bool {
+	_, ok := s.coveringAncestor(c)
+	return ok
+}
+
+// Len returns the number of cells in the set.
+func (s CellSet) Len() int {
+	return len(s)
+}
+
+// All returns an iterator over the cells in the set, in no particular order.
+func (s CellSet) All() iter.Seq[Cell] {
+	return maps.Keys(s)
+}
+
+// Cells returns the cells in the set, sorted by index.
+func (s CellSet) Cells() []Cell {
+	return slices.Sorted(maps.Keys(s))
+}
+
+// Clone returns a copy of the set.
+func (s CellSet) Clone() CellSet {
+	return maps.Clone(s)
+}
+
+// Union returns the area covered by either set.
+func (s CellSet) Union(other CellSet) CellSet {
+	out := make(CellSet, len(s)+len(other))
+	maps.Copy(out, s)
+	maps.Copy(out, other)
+
+	return out.withoutCoveredDescendants()
+}
+
+// Intersection returns the area covered by both sets.
+func (s CellSet) Intersection(other CellSet) CellSet {
+	out := make(CellSet)
+
+	for c := range s {
+		if other.Covers(c) {
+			out.Add(c)
+		}
+	}
+
+	for c := range other {
+		if s.Covers(c) {
+			out.Add(c)
+		}
+	}
+
+	return out.withoutCoveredDescendants()
+}
+
+// Difference returns the area covered by this set but not by other. Cells
+// partially covered by other are replaced by those of their descendants, at
+// the resolutions of the cells in other, that are not covered.
+func (s CellSet) Difference(other CellSet) CellSet {
+	base := s.withoutCoveredDescendants()
+
+	// The cells of other within each cell of s, which must be carved out.
+	holes := make(map[Cell][]Cell)
+
+	for c := range other {
+		if ancestor, ok := base.coveringAncestor(c); ok && ancestor != c {
+			holes[ancestor] = append(holes[ancestor], c)
+		}
+	}
+
+	out := make(CellSet, len(base))
+
+	for c := range base {
+		if other.Covers(c) {
+			continue
+		}
+		out.addDifference(c, holes[c])
+	}
+
+	return out.withoutCoveredDescendants()
+}
+
+// SymmetricDifference returns the area covered by exactly one of the sets.
+func (s CellSet) SymmetricDifference(other CellSet) CellSet {
+	return s.Difference(other).Union(other.Difference(s))
+}
+
+// Compact returns the set with every complete group of children replaced by
+// their parent, recursively, and with cells covered by an ancestor in the set
+// removed. Unlike CompactCells, the set may hold mixed resolutions.
+func (s CellSet) Compact() CellSet {
+	var byRes [MaxResolution + 1]CellSet
+
+	for c := range s.withoutCoveredDescendants() {
+		r := c.Resolution()
+		if byRes[r] == nil {
+			byRes[r] = make(CellSet)
+		}
+		byRes[r].Add(c)
+	}
+
+	out := make(CellSet)
+
+	for r := MaxResolution; r > 0; r-- {
+		children := make(map[Cell][]Cell)
+		for c := range byRes[r] {
+			parent, _ := c.ImmediateParent()
+			children[parent] = append(children[parent], c)
+		}
+
+		for parent, cs := range children {
+			if len(cs) == childCount(parent) {
+				if byRes[r-1] == nil {
+					byRes[r-1] = make(CellSet)
+				}
+				byRes[r-1].Add(parent)
+			} else {
+				out.Add(cs...)
+			}
+		}
+	}
+
+	for c := range byRes[0] {
+		out.Add(c)
+	}
+
+	return out
+}
+
+// Uncompact returns the set with every cell coarser than resolution replaced
+// by its descendants at resolution. ErrRsolutionMismatch is returned if the set
+// holds cells finer than resolution.
+func (s CellSet) Uncompact(resolution int) (CellSet, error) {
+	if len(s) == 0 {
+		return make(CellSet), nil
+	}
+
+	cells, err := UncompactCells(s.Cells(), resolution)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewCellSet(cells...), nil
+}
+
+// MultiPolygon returns the outlines of the set as CellsToMultiPolygon does.
+// Cells of mixed resolutions are first uncompacted to the finest resolution
+// in the set.
+func (s CellSet) MultiPolygon() ([]GeoPolygon, error) {
+	if len(s) == 0 {
+		return nil, nil
+	}
+
+	finest := 0
+	for c := range s {
+		finest = max(finest, c.Resolution())
+	}
+
+	cells, err := s.withoutCoveredDescendants().Uncompact(finest)
+	if err != nil {
+		return nil, err
+	}
+
+	return CellsToMultiPolygon(cells.Cells())
+}
+
+// addDifference adds the parts of c not covered by holes, which are
+// descendants of c.
+func (s CellSet) addDifference(c Cell, holes []Cell) {
+	if len(holes) == 0 {
+		s.Add(c)
+		return
+	}
+
+	res := c.Resolution() + 1
+	within := make(map[Cell][]Cell)
+
+	for _, h := range holes {
+		if h == c {
+			return
+		}
+
+		child, _ := h.Parent(res)
+		within[child] = append(within[child], h)
+	}
+
+	children, _ := c.ImmediateChildren()
+	for _, child := range children {
+		s.addDifference(child, within[child])
+	}
+}
+
+// coveringAncestor returns the cell or its coarsest ancestor in the set.
+func (s CellSet) coveringAncestor(c Cell) (Cell, bool) {
+	for r := 0; r <= c.Resolution(); r++ {
+		ancestor, err := c.Parent(r)
+		if err == nil && s.Contains(ancestor) {
+			return ancestor, true
+		}
+	}
+
+	return 0, false
+}
+
+// coversStrictly returns whether an ancestor of c, but not c itself, is in the
+// set.
+func (s CellSet) coversStrictly(c Cell) bool {
+	ancestor, ok := s.coveringAncestor(c)
+	return ok && ancestor != c
+}
+
+// withoutCoveredDescendants returns the set without cells that have an
+// ancestor in the set.
+func (s CellSet) withoutCoveredDescendants() CellSet {
+	out := make(CellSet, len(s))
+
+	for c := range s {
+		if !s.coversStrictly(c) {
+			out.Add(c)
+		}
+	}
+
+	return out
+}
+
+// childCount returns the number of immediate children of the cell.
+func childCount(c Cell) int {
+	if c.IsPentagon() {
+		return 6 //nolint:mnd // pentagons have one deleted child
+	}
+
+	return 7 //nolint:mnd // aperture 7
+}
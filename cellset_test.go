@@ -0,0 +1,189 @@
+/*
+ * Copyright 2026 Uber Technologies, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *         http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package h3
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestCellSet(t *testing.T) {
+	t.Parallel()
+
+	s := NewCellSet(validCell, validCell, pentagonCell)
+	assertEqual(t, 2, s.Len())
+	assertTrue(t, s.Contains(validCell))
+	assertFalse(t, s.Contains(lineStartCell))
+
+	s.Add(lineStartCell)
+	assertTrue(t, s.Contains(lineStartCell))
+
+	s.Remove(lineStartCell, lineEndCell)
+	assertFalse(t, s.Contains(lineStartCell))
+	assertEqual(t, 2, s.Len())
+
+	expected := []Cell{validCell, pentagonCell}
+	slices.Sort(expected)
+	assertEqualCells(t, expected, s.Cells())
+	assertEqualCells(t, expected, slices.Sorted(s.All()))
+
+	clone := s.Clone()
+	clone.Add(lineEndCell)
+	assertFalse(t, s.Contains(lineEndCell))
+}
+
+func TestCellSet_Covers(t *testing.T) {
+	t.Parallel()
+
+	child, _ := validCell.CenterChild(8)
+	s := NewCellSet(validCell)
+
+	assertTrue(t, s.Covers(validCell))
+	assertTrue(t, s.Covers(child))
+	assertFalse(t, NewCellSet(child).Covers(validCell))
+}
+
+func TestCellSet_Algebra(t *testing.T) {
+	t.Parallel()
+
+	children, _ := validCell.ImmediateChildren()
+	grandchild, _ := children[1].CenterChild(validCell.Resolution() + 2)
+	parent := NewCellSet(validCell)
+	child := NewCellSet(children[0])
+
+	t.Run("union", func(t *testing.T) {
+		t.Parallel()
+
+		assertEqualCells(t, []Cell{validCell}, parent.Union(child).Cells())
+		assertEqualCells(t, []Cell{validCell}, child.Union(parent).Cells())
+
+		u := child.Union(NewCellSet(children[1]))
+		assertEqual(t, 2, u.Len())
+	})
+
+	t.Run("intersection", func(t *testing.T) {
+		t.Parallel()
+
+		assertEqualCells(t, []Cell{children[0]}, parent.Intersection(child).Cells())
+		assertEqualCells(t, []Cell{children[0]}, child.Intersection(parent).Cells())
+		assertEqual(t, 0, child.Intersection(NewCellSet(children[1])).Len())
+		assertEqual(t, 0, parent.Intersection(NewCellSet(pentagonCell)).Len())
+	})
+
+	t.Run("difference", func(t *testing.T) {
+		t.Parallel()
+
+		assertEqual(t, 0, child.Difference(parent).Len())
+
+		d := parent.Difference(child)
+		assertEqualCells(t, children[1:], d.Cells())
+
+		// Carving out a grandchild splits its parent too.
+		d = parent.Difference(NewCellSet(children[0], grandchild))
+		assertEqual(t, 5+6, d.Len())
+		assertFalse(t, d.Covers(children[0]))
+		assertFalse(t, d.Covers(grandchild))
+		assertTrue(t, d.Union(NewCellSet(children[0], grandchild)).Compact().Contains(validCell))
+
+		assertEqualCells(t, parent.Cells(), parent.Difference(NewCellSet(pentagonCell)).Cells())
+	})
+
+	t.Run("symmetric difference", func(t *testing.T) {
+		t.Parallel()
+
+		assertEqualCells(t, children[1:], parent.SymmetricDifference(child).Cells())
+		assertEqualCells(t, children[1:], child.SymmetricDifference(parent).Cells())
+
+		other := NewCellSet(pentagonCell)
+		expected := []Cell{validCell, pentagonCell}
+		slices.Sort(expected)
+		assertEqualCells(t, expected, parent.SymmetricDifference(other).Cells())
+	})
+
+	t.Run("inputs unchanged", func(t *testing.T) {
+		t.Parallel()
+
+		s := NewCellSet(validCell, children[0])
+		_ = s.Union(child)
+		_ = s.Difference(child)
+		assertEqual(t, 2, s.Len())
+	})
+}
+
+func TestCellSet_Compact(t *testing.T) {
+	t.Parallel()
+
+	for _, c := range []Cell{validCell, pentagonCell} {
+		children, err := c.Children(c.Resolution() + 2)
+		assertNoErr(t, err)
+
+		s := NewCellSet(children...)
+		compacted := s.Compact()
+		assertEqualCells(t, []Cell{c}, compacted.Cells())
+
+		// Mixed resolutions, with a redundant descendant.
+		immediate, _ := c.ImmediateChildren()
+		grandchildren, _ := immediate[0].ImmediateChildren()
+		mixed := NewCellSet(immediate[1:]...)
+		mixed.Add(grandchildren...)
+		mixed.Add(children[0])
+		assertEqualCells(t, []Cell{c}, mixed.Compact().Cells())
+
+		uncompacted, err := compacted.Uncompact(c.Resolution() + 2)
+		assertNoErr(t, err)
+		assertEqualCells(t, s.Cells(), uncompacted.Cells())
+	}
+
+	partial := NewCellSet(validCell, lineStartCell)
+	assertEqualCells(t, partial.Cells(), partial.Compact().Cells())
+
+	_, err := NewCellSet(lineStartCell).Uncompact(0)
+	assertErrIs(t, err, ErrRsolutionMismatch)
+
+	empty, err := NewCellSet().Uncompact(5)
+	assertNoErr(t, err)
+	assertEqual(t, 0, empty.Len())
+}
+
+func TestCellSet_Polygons(t *testing.T) {
+	t.Parallel()
+
+	s, err := PolygonToCellSet(validGeoPolygonHoles, 9)
+	assertNoErr(t, err)
+
+	cells, _ := PolygonToCells(validGeoPolygonHoles, 9)
+	assertEqual(t, len(cells), s.Len())
+
+	expected, err := CellsToMultiPolygon(s.Cells())
+	assertNoErr(t, err)
+
+	// Compacting does not change the outline.
+	polygons, err := s.Compact().MultiPolygon()
+	assertNoErr(t, err)
+	assertEqual(t, len(expected), len(polygons))
+	for i := range expected {
+		assertEqual(t, len(expected[i].GeoLoop), len(polygons[i].GeoLoop))
+		assertEqual(t, len(expected[i].Holes), len(polygons[i].Holes))
+	}
+
+	polygons, err = NewCellSet().MultiPolygon()
+	assertNoErr(t, err)
+	assertEqual(t, 0, len(polygons))
+
+	_, err = PolygonToCellSet(validGeoPolygonHoles, -1)
+	assertErrIs(t, err, ErrResolutionDomain)
+}
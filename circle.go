@@ -0,0 +1,248 @@
+/*
+ * Copyright 2026 Uber Technologies, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *         http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package h3
+
+/*
+#include <h3_h3api.h>
+*/
+import "C"
+
+import (
+	"math"
+	"slices"
+)
+
+// CircleToCells returns the cells at resolution within radiusM meters of
+// center, sorted by index. Distances are great-circle distances, and mode
+// selects cells like it does for PolygonToCellsExperimental:
+//
+//   - ContainmentCenter: the cell center is within the circle.
+//   - ContainmentFull: every vertex of the cell is within the circle.
+//   - ContainmentOverlapping: any point of the cell is within the circle.
+//   - ContainmentOverlappingBbox: any point of the cell's bounding box is
+//     within the circle.
+//
+// Candidate cells are taken from a grid disk around the cell containing
+// center, with k chosen from the spacing of the cells there so that no
+// qualifying cell is missed, including near pentagons. When the disk would
+// cover the globe, every cell at resolution is a candidate instead.
+// ContainmentFull assumes the circle is smaller than a hemisphere.
+//
+// ErrMemoryBounds is returned if there would be more than 2^27 candidate
+// cells.
+func CircleToCells(center LatLng, radiusM float64, resolution int, mode ContainmentMode) ([]Cell, error) {
+	if !(radiusM >= 0) || math.IsInf(radiusM, 1) {
+		return nil, toOpErr(C.E_DOMAIN, "CircleToCells", radiusM, resolution, mode)
+	}
+	if mode >= ContainmentInvalid {
+		return nil, toOpErr(C.E_OPTION_INVALID, "CircleToCells", radiusM, resolution, mode)
+	}
+
+	origin, err := LatLngToCell(center, resolution)
+	if err != nil {
+		return nil, err
+	}
+
+	radius := radiusM / (earthRadiusKm * 1000) //nolint:mnd // meters per km
+
+	k, err := circleDiskK(origin, radius)
+	if err != nil {
+		return nil, err
+	}
+
+	// Compare sizes as floats, since k can be far beyond the range of int.
+	diskSize := 3*k*(k+1) + 1 //nolint:mnd // maxGridDiskSize
+	numCells := float64(NumCells(resolution))
+
+	var candidates []Cell
+	switch {
+	case min(diskSize, numCells) > maxCircleCandidates:
+		return nil, toOpErr(C.E_MEMORY_BOUNDS, "CircleToCells", radiusM, resolution, mode)
+	case diskSize >= numCells:
+		candidates = slices.Collect(CellsSeq(resolution))
+	default:
+		candidates, err = origin.GridDisk(int(k))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	out := candidates[:0]
+	for _, c := range candidates {
+		ok, err := circleContainsCell(center, radius, origin, c, mode)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			out = append(out, c)
+		}
+	}
+
+	slices.Sort(out)
+
+	return out, nil
+}
+
+// maxCircleCandidates bounds the number of candidate cells CircleToCells
+// examines, which keeps the candidates within 1 GiB.
+const maxCircleCandidates = 1 << 27
+
+// circleDiskK returns a grid distance from origin beyond which no cell touches
+// a circle of radius, in radians, around a point in origin. It is returned as
+// a float64 because it can exceed the range of int for large circles.
+func circleDiskK(origin Cell, radius float64) (float64, error) {
+	center, err := origin.LatLng()
+	if err != nil {
+		return 0, err
+	}
+
+	boundary, err := origin.Boundary()
+	if err != nil {
+		return 0, err
+	}
+
+	var cellRadius float64
+	for _, v := range boundary {
+		cellRadius = max(cellRadius, GreatCircleDistanceRads(center, v))
+	}
+
+	neighbors, err := origin.GridDisk(1)
+	if err != nil {
+		return 0, err
+	}
+
+	spacing := math.Inf(1)
+	for _, n := range neighbors {
+		if n == origin {
+			continue
+		}
+
+		nc, err := n.LatLng()
+		if err != nil {
+			return 0, err
+		}
+		spacing = min(spacing, GreatCircleDistanceRads(center, nc))
+	}
+
+	// The centers of ring k are at least k*spacing*sqrt(3)/2 from the origin on
+	// a uniform grid. Allow cells farther away to be up to half as large, and
+	// the circle to reach up to a cell radius past a cell's center, counting
+	// from anywhere in the origin cell.
+	reach := radius + 2*cellRadius
+
+	return math.Ceil(reach / (spacing * math.Sqrt(3) / 4)), nil //nolint:mnd // see above
+}
+
+// circleContainsCell reports whether the cell qualifies for the circle of
+// radius, in radians, around center, which lies in origin.
+func circleContainsCell(center LatLng, radius float64, origin, c Cell, mode ContainmentMode) (bool, error) {
+	switch mode {
+	case ContainmentCenter:
+		cellCenter, err := c.LatLng()
+		if err != nil {
+			return false, err
+		}
+
+		return GreatCircleDistanceRads(center, cellCenter) <= radius, nil
+
+	case ContainmentFull:
+		boundary, err := c.Boundary()
+		if err != nil {
+			return false, err
+		}
+
+		for _, v := range boundary {
+			if GreatCircleDistanceRads(center, v) > radius {
+				return false, nil
+			}
+		}
+
+		return true, nil
+
+	case ContainmentOverlapping:
+		if c == origin {
+			return true, nil
+		}
+
+		boundary, err := c.Boundary()
+		if err != nil {
+			return false, err
+		}
+
+		for i, v := range boundary {
+			if arcDistanceRads(center, v, boundary[(i+1)%len(boundary)]) <= radius {
+				return true, nil
+			}
+		}
+
+		return false, nil
+
+	default:
+		bbox, err := c.BBox(false)
+		if err != nil {
+			return false, err
+		}
+
+		return bboxDistanceRads(center, bbox) <= radius, nil
+	}
+}
+
+// arcDistanceRads returns the great-circle distance in radians from p to the
+// nearest point of the shorter arc between a and b.
+func arcDistanceRads(p, a, b LatLng) float64 {
+	endpoints := min(GreatCircleDistanceRads(p, a), GreatCircleDistanceRads(p, b))
+
+	va, vb, vp := unitVector(a), unitVector(b), unitVector(p)
+
+	n := cross(va, vb)
+	norm := math.Sqrt(dot(n, n))
+	if norm == 0 {
+		return endpoints
+	}
+	for i := range n {
+		n[i] /= norm
+	}
+
+	// The nearest point of the great circle is the projection of p onto its
+	// plane, which is on the arc if it lies between a and b.
+	proj := vp
+	offset := dot(vp, n)
+	for i := range proj {
+		proj[i] -= offset * n[i]
+	}
+	if dot(cross(va, proj), n) < 0 || dot(cross(proj, vb), n) < 0 {
+		return endpoints
+	}
+
+	return math.Asin(min(math.Abs(offset), 1))
+}
+
+// bboxDistanceRads returns the great-circle distance in radians from p to the
+// nearest point of the bounding box, or 0 if p is inside it.
+func bboxDistanceRads(p LatLng, b BBox) float64 {
+	// Within the longitudes of the box, the nearest point is on the same
+	// meridian. Elsewhere, it is on the meridian of the west or east edge.
+	clamped := LatLng{Lat: min(max(p.Lat, b.South), b.North), Lng: p.Lng}
+	if b.Contains(clamped) {
+		return GreatCircleDistanceRads(p, clamped)
+	}
+
+	west := arcDistanceRads(p, LatLng{Lat: b.South, Lng: b.West}, LatLng{Lat: b.North, Lng: b.West})
+	east := arcDistanceRads(p, LatLng{Lat: b.South, Lng: b.East}, LatLng{Lat: b.North, Lng: b.East})
+
+	return min(west, east)
+}
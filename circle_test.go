@@ -0,0 +1,163 @@
+/*
+ * Copyright 2026 Uber Technologies, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *         http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package h3
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCircleToCells(t *testing.T) {
+	t.Parallel()
+
+	pentagonCenter, _ := CellToLatLng(pentagonCell)
+
+	for _, tc := range []struct {
+		name    string
+		center  LatLng
+		radiusM float64
+		res     int
+	}{
+		{"hexagon", validLatLng1, 3000, 8},
+		{"pentagon", pentagonCenter, 100000, 4},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			modes := []ContainmentMode{ContainmentFull, ContainmentCenter, ContainmentOverlapping, ContainmentOverlappingBbox}
+			results := make([]CellSet, len(modes))
+
+			for i, mode := range modes {
+				cells, err := CircleToCells(tc.center, tc.radiusM, tc.res, mode)
+				assertNoErr(t, err)
+				assertTrue(t, len(cells) > 0)
+				assertEqualCells(t, circleBruteForce(t, tc.center, tc.radiusM, tc.res, mode), cells)
+
+				results[i] = NewCellSet(cells...)
+				if i > 0 {
+					assertEqual(t, 0, results[i-1].Difference(results[i]).Len())
+				}
+			}
+		})
+	}
+
+	t.Run("zero radius", func(t *testing.T) {
+		t.Parallel()
+
+		origin, _ := LatLngToCell(validLatLng1, 9)
+
+		cells, err := CircleToCells(validLatLng1, 0, 9, ContainmentOverlapping)
+		assertNoErr(t, err)
+		assertEqualCells(t, []Cell{origin}, cells)
+
+		cells, err = CircleToCells(validLatLng1, 0, 9, ContainmentFull)
+		assertNoErr(t, err)
+		assertEqual(t, 0, len(cells))
+	})
+
+	t.Run("globe", func(t *testing.T) {
+		t.Parallel()
+
+		// Half the circumference reaches every point, so every cell qualifies.
+		halfCircumferenceM := math.Pi * earthRadiusKm * 1000
+		cells, err := CircleToCells(validLatLng1, halfCircumferenceM, 1, ContainmentCenter)
+		assertNoErr(t, err)
+		assertEqual(t, NumCells(1), len(cells))
+
+		cells, err = CircleToCells(validLatLng1, 1e7, 0, ContainmentOverlapping)
+		assertNoErr(t, err)
+		assertTrue(t, len(cells) > 0 && len(cells) < NumCells(0))
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := CircleToCells(validLatLng1, -1, 9, ContainmentCenter)
+		assertErrIs(t, err, ErrDomain)
+		_, err = CircleToCells(validLatLng1, math.NaN(), 9, ContainmentCenter)
+		assertErrIs(t, err, ErrDomain)
+		_, err = CircleToCells(validLatLng1, math.Inf(1), 5, ContainmentCenter)
+		assertErrIs(t, err, ErrDomain)
+		_, err = CircleToCells(validLatLng1, 5e6, MaxResolution, ContainmentCenter)
+		assertErrIs(t, err, ErrMemoryBounds)
+		_, err = CircleToCells(validLatLng1, 1000, 9, ContainmentInvalid)
+		assertErrIs(t, err, ErrOptionInvalid)
+		_, err = CircleToCells(validLatLng1, 1000, MaxResolution+1, ContainmentCenter)
+		assertErrIs(t, err, ErrResolutionDomain)
+	})
+}
+
+func TestArcDistanceRads(t *testing.T) {
+	t.Parallel()
+
+	a := LatLng{Lat: 0, Lng: 0}
+	b := LatLng{Lat: 0, Lng: 10}
+
+	// Above the middle of the arc, the nearest point is on the equator.
+	assertEqualEps(t, DegsToRads*5, arcDistanceRads(LatLng{Lat: 5, Lng: 5}, a, b))
+	// Past the end of the arc, the nearest point is the endpoint.
+	p := LatLng{Lat: 0, Lng: 20}
+	assertEqualEps(t, GreatCircleDistanceRads(p, b), arcDistanceRads(p, a, b))
+	assertEqualEps(t, 0, arcDistanceRads(a, a, a))
+}
+
+// circleBruteForce applies the mode to a grid disk twice as large as needed.
+func circleBruteForce(t *testing.T, center LatLng, radiusM float64, res int, mode ContainmentMode) []Cell {
+	t.Helper()
+
+	origin, err := LatLngToCell(center, res)
+	assertNoErr(t, err)
+	k, err := circleDiskK(origin, radiusM/(earthRadiusKm*1000))
+	assertNoErr(t, err)
+	disk, err := origin.GridDisk(2 * int(k))
+	assertNoErr(t, err)
+
+	radius := radiusM / (earthRadiusKm * 1000)
+	out := NewCellSet()
+	for _, c := range disk {
+		switch mode {
+		case ContainmentCenter, ContainmentFull:
+			ok, err := circleContainsCell(center, radius, origin, c, mode)
+			assertNoErr(t, err)
+			if ok {
+				out.Add(c)
+			}
+		case ContainmentOverlapping:
+			// Sample the boundary densely instead of using arc distances.
+			boundary, _ := c.Boundary()
+			for i, v := range boundary {
+				for f := 0.0; f <= 1; f += 0.02 {
+					p := GreatCircleInterpolate(v, boundary[(i+1)%len(boundary)], f)
+					if GreatCircleDistanceRads(center, p) <= radius {
+						out.Add(c)
+					}
+				}
+			}
+			if c == origin {
+				out.Add(c)
+			}
+		default:
+			ok, err := circleContainsCell(center, radius, origin, c, mode)
+			assertNoErr(t, err)
+			if ok {
+				out.Add(c)
+			}
+		}
+	}
+
+	return out.Cells()
+}
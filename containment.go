@@ -0,0 +1,209 @@
+/*
+ * Copyright 2026 Uber Technologies, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *         http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package h3
+
+/*
+#include <h3_h3api.h>
+*/
+import "C"
+
+import "slices"
+
+// ContainmentIndex answers containment and intersection queries against a
+// fixed collection of cells of mixed resolutions, such as the output of
+// CompactCells. It is immutable and safe for concurrent use.
+//
+// Beyond validating the query, lookups do not call into H3 Core. Checking
+// whether a cell is contained costs one map lookup per distinct resolution in
+// the index, and finding the stored descendants of a cell costs one binary
+// search per finer resolution.
+type ContainmentIndex struct {
+	cells map[Cell]struct{}
+	// byRes holds the stored cells at each resolution, sorted.
+	byRes [MaxResolution + 1][]Cell
+	// resolutions lists the resolutions holding at least one cell, ascending.
+	resolutions []int
+}
+
+// NewContainmentIndex returns an index of the cells. Duplicates and cells
+// covered by an ancestor in the input are allowed. ErrCellInvalid is returned
+// if any cell is not valid.
+func NewContainmentIndex(cells []Cell) (*ContainmentIndex, error) {
+	idx := &ContainmentIndex{cells: make(map[Cell]struct{}, len(cells))}
+
+	for _, c := range cells {
+		if !c.IsValid() {
+			return nil, toOpErr(C.E_CELL_INVALID, "NewContainmentIndex", c)
+		}
+		if _, ok := idx.cells[c]; ok {
+			continue
+		}
+
+		idx.cells[c] = struct{}{}
+		r := c.Resolution()
+		idx.byRes[r] = append(idx.byRes[r], c)
+	}
+
+	for r := range idx.byRes {
+		if len(idx.byRes[r]) > 0 {
+			slices.Sort(idx.byRes[r])
+			idx.resolutions = append(idx.resolutions, r)
+		}
+	}
+
+	return idx, nil
+}
+
+// Len returns the number of distinct cells in the index.
+func (idx *ContainmentIndex) Len() int {
+	return len(idx.cells)
+}
+
+// Cells returns the cells in the index, sorted by resolution and then index.
+func (idx *ContainmentIndex) Cells() []Cell {
+	out := make([]Cell, 0, len(idx.cells))
+	for _, r := range idx.resolutions {
+		out = append(out, idx.byRes[r]...)
+	}
+
+	return out
+}
+
+// Contains returns whether the cell or one of its ancestors is in the index.
+func (idx *ContainmentIndex) Contains(c Cell) bool {
+	_, ok := idx.Container(c)
+	return ok
+}
+
+// Container returns the coarsest cell in the index that is the cell or one of
+// its ancestors, and whether there is one.
+func (idx *ContainmentIndex) Container(c Cell) (Cell, bool) {
+	if !c.IsValid() {
+		return 0, false
+	}
+
+	res := c.Resolution()
+	for _, r := range idx.resolutions {
+		if r > res {
+			break
+		}
+
+		ancestor := parentBits(c, r)
+		if _, ok := idx.cells[ancestor]; ok {
+			return ancestor, true
+		}
+	}
+
+	return 0, false
+}
+
+// ContainsLatLng returns whether the point lies in a cell of the index.
+func (idx *ContainmentIndex) ContainsLatLng(latLng LatLng) (bool, error) {
+	if len(idx.resolutions) == 0 {
+		return false, nil
+	}
+
+	c, err := LatLngToCell(latLng, idx.resolutions[len(idx.resolutions)-1])
+	if err != nil {
+		return false, err
+	}
+
+	return idx.Contains(c), nil
+}
+
+// Intersecting returns the cells in the index that overlap the cell: the cell
+// itself, its ancestors and its descendants. They are sorted by resolution and
+// then index.
+func (idx *ContainmentIndex) Intersecting(c Cell) []Cell {
+	if !c.IsValid() {
+		return nil
+	}
+
+	var out []Cell
+
+	res := c.Resolution()
+	for _, r := range idx.resolutions {
+		if r <= res {
+			ancestor := parentBits(c, r)
+			if _, ok := idx.cells[ancestor]; ok {
+				out = append(out, ancestor)
+			}
+
+			continue
+		}
+
+		// Descendants at a resolution are contiguous in index order, between
+		// the ones with all digits below res set to 0 and to 6.
+		cells := idx.byRes[r]
+		first, _ := slices.BinarySearch(cells, descendantBits(c, r, 0))
+		last, found := slices.BinarySearch(cells, descendantBits(c, r, 6)) //nolint:mnd // largest digit
+		if found {
+			last++
+		}
+		out = append(out, cells[first:last]...)
+	}
+
+	return out
+}
+
+// Intersects returns whether any cell in the index overlaps the cell.
+func (idx *ContainmentIndex) Intersects(c Cell) bool {
+	if idx.Contains(c) {
+		return true
+	}
+	if !c.IsValid() {
+		return false
+	}
+
+	res := c.Resolution()
+	for _, r := range idx.resolutions {
+		if r <= res {
+			continue
+		}
+
+		cells := idx.byRes[r]
+		i, found := slices.BinarySearch(cells, descendantBits(c, r, 0))
+		if found || (i < len(cells) && cells[i] <= descendantBits(c, r, 6)) { //nolint:mnd // largest digit
+			return true
+		}
+	}
+
+	return false
+}
+
+// parentBits returns the ancestor of a valid cell at resolution, which must
+// not be finer than the cell, without validating it.
+func parentBits(c Cell, resolution int) Cell {
+	h := uint64(c)&^(resMask<<resOffset) | uint64(resolution)<<resOffset
+	for r := resolution + 1; r <= MaxResolution; r++ {
+		h |= digitMask << digitShift(r)
+	}
+
+	return Cell(h)
+}
+
+// descendantBits returns the descendant of a valid cell at resolution whose
+// digits finer than the cell's resolution are all digit. The result may be a
+// deleted pentagon subsequence.
+func descendantBits(c Cell, resolution, digit int) Cell {
+	h := uint64(c)&^(resMask<<resOffset) | uint64(resolution)<<resOffset
+	for r := c.Resolution() + 1; r <= resolution; r++ {
+		h = h&^(digitMask<<digitShift(r)) | uint64(digit)<<digitShift(r)
+	}
+
+	return Cell(h)
+}
@@ -0,0 +1,127 @@
+/*
+ * Copyright 2026 Uber Technologies, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *         http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package h3
+
+import "testing"
+
+func TestContainmentIndex(t *testing.T) {
+	t.Parallel()
+
+	cells, err := PolygonToCells(validGeoPolygonHoles, 9)
+	assertNoErr(t, err)
+	compacted, err := CompactCells(cells)
+	assertNoErr(t, err)
+
+	idx, err := NewContainmentIndex(append(compacted, compacted[0]))
+	assertNoErr(t, err)
+	assertEqual(t, len(compacted), idx.Len())
+	assertEqual(t, len(compacted), len(idx.Cells()))
+
+	filled := NewCellSet(cells...)
+
+	t.Run("contains", func(t *testing.T) {
+		t.Parallel()
+
+		candidates, err := PolygonToCellsExperimental(validGeoPolygonHoles, 9, ContainmentOverlapping)
+		assertNoErr(t, err)
+
+		for _, c := range candidates {
+			assertEqual(t, filled.Contains(c), idx.Contains(c), c)
+
+			finer, _ := c.CenterChild(12)
+			assertEqual(t, filled.Contains(c), idx.Contains(finer), finer)
+
+			center, _ := c.LatLng()
+			ok, err := idx.ContainsLatLng(center)
+			assertNoErr(t, err)
+			assertEqual(t, filled.Contains(c), ok, center)
+		}
+
+		assertFalse(t, idx.Contains(0))
+		assertFalse(t, idx.Contains(pentagonCell))
+	})
+
+	t.Run("container", func(t *testing.T) {
+		t.Parallel()
+
+		for _, c := range compacted {
+			child, _ := c.CenterChild(c.Resolution() + 3)
+			container, ok := idx.Container(child)
+			assertTrue(t, ok)
+			assertEqual(t, c, container)
+		}
+
+		_, ok := idx.Container(pentagonCell)
+		assertFalse(t, ok)
+	})
+
+	t.Run("intersecting", func(t *testing.T) {
+		t.Parallel()
+
+		ancestor, _ := cells[0].Parent(5)
+
+		var expected []Cell
+		for _, c := range idx.Cells() {
+			if p, _ := c.Parent(5); p == ancestor {
+				expected = append(expected, c)
+			}
+		}
+		assertTrue(t, len(expected) > 1)
+		assertEqualCells(t, expected, idx.Intersecting(ancestor))
+		assertTrue(t, idx.Intersects(ancestor))
+
+		for _, c := range compacted {
+			assertEqualCells(t, []Cell{c}, idx.Intersecting(c))
+
+			child, _ := c.CenterChild(c.Resolution() + 1)
+			assertEqualCells(t, []Cell{c}, idx.Intersecting(child))
+			assertTrue(t, idx.Intersects(child))
+		}
+
+		assertEqual(t, 0, len(idx.Intersecting(pentagonCell)))
+		assertFalse(t, idx.Intersects(pentagonCell))
+		assertEqual(t, 0, len(idx.Intersecting(0)))
+	})
+}
+
+func TestContainmentIndex_Mixed(t *testing.T) {
+	t.Parallel()
+
+	children, _ := validCell.ImmediateChildren()
+	grandchild, _ := children[6].CenterChild(validCell.Resolution() + 2)
+	pentagonChild, _ := pentagonCell.CenterChild(pentagonCell.Resolution() + 3)
+
+	idx, err := NewContainmentIndex([]Cell{children[0], grandchild, pentagonChild})
+	assertNoErr(t, err)
+
+	assertEqualCells(t, []Cell{children[0], grandchild}, idx.Intersecting(validCell))
+	assertEqualCells(t, []Cell{grandchild}, idx.Intersecting(children[6]))
+	assertEqual(t, 0, len(idx.Intersecting(children[3])))
+	assertFalse(t, idx.Intersects(children[3]))
+	assertFalse(t, idx.Contains(validCell))
+
+	assertEqualCells(t, []Cell{pentagonChild}, idx.Intersecting(pentagonCell))
+
+	empty, err := NewContainmentIndex(nil)
+	assertNoErr(t, err)
+	ok, err := empty.ContainsLatLng(validLatLng1)
+	assertNoErr(t, err)
+	assertFalse(t, ok)
+
+	_, err = NewContainmentIndex([]Cell{validCell, 0})
+	assertErrIs(t, err, ErrCellInvalid)
+}
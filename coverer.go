@@ -0,0 +1,245 @@
+/*
+ * Copyright 2026 Uber Technologies, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *         http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package h3
+
+/*
+#include <h3_h3api.h>
+*/
+import "C"
+
+import (
+	"container/heap"
+	"fmt"
+	"slices"
+)
+
+// RegionCoverer approximates polygons with mixed-resolution coverings of
+// bounded size, similar in spirit to the S2 RegionCoverer.
+//
+// The covering is built from the cells PolygonToCellsExperimental returns at
+// MaxResolution for Mode, called the target cells. Every target cell is
+// covered by exactly one cell of the covering, which is the target cell or one
+// of its ancestors no coarser than MinResolution. Cells coarser than
+// MaxResolution also cover some non-target cells, and the coverer picks the
+// covering with the least of this over-coverage it can find within MaxCells.
+//
+// The target cells are never listed individually. The coverer works from the
+// compacted target cells of PolygonToCompactCellsSeq, so its time and memory
+// grow with their number, which is roughly the number of cells at
+// MaxResolution along the polygon boundary, rather than with the area of the
+// polygon. Compacted cells coarser than MinResolution are split into their
+// children at MinResolution, all of which are part of the covering.
+type RegionCoverer struct {
+	// MinResolution is the coarsest resolution of cells in the covering.
+	MinResolution int
+	// MaxResolution is the finest resolution of cells in the covering, and
+	// the resolution the polygon is filled at.
+	MaxResolution int
+	// MaxCells is the desired maximum number of cells in the covering, or 0
+	// for no limit. The covering holds more cells only if the target cells
+	// have more distinct ancestors at MinResolution.
+	MaxCells int
+	// Mode selects the target cells at MaxResolution.
+	Mode ContainmentMode
+}
+
+// Covering returns a covering of the polygon, sorted by index. Without a
+// MaxCells limit, it is the compacted set of target cells, with cells coarser
+// than MinResolution split.
+//
+// The covering is computed greedily: starting from the ancestors of the target
+// cells at MinResolution, it repeatedly splits the cell that removes the most
+// over-coverage per added cell while the budget allows. Over-coverage is
+// measured in cells at MaxResolution.
+func (rc RegionCoverer) Covering(polygon GeoPolygon) ([]Cell, error) {
+	if rc.MinResolution < 0 || rc.MinResolution > rc.MaxResolution || rc.MaxResolution > MaxResolution {
+		return nil, toOpErr(C.E_RES_DOMAIN, "RegionCoverer.Covering", rc.MinResolution, rc.MaxResolution)
+	}
+
+	tree, err := newCoverTree(polygon, rc.MinResolution, rc.MaxResolution, rc.Mode)
+	if err != nil {
+		return nil, err
+	}
+
+	covering := make(CellSet, len(tree.roots))
+	candidates := &coverQueue{}
+
+	for _, c := range tree.roots {
+		covering.Add(c)
+		tree.push(candidates, c)
+	}
+
+	for candidates.Len() > 0 {
+		candidate := candidates.pop()
+		children := tree.children[candidate.cell]
+
+		if rc.MaxCells > 0 && len(covering)+len(children)-1 > rc.MaxCells {
+			continue
+		}
+
+		covering.Remove(candidate.cell)
+		for _, child := range children {
+			covering.Add(child)
+			tree.push(candidates, child)
+		}
+	}
+
+	return covering.Cells(), nil
+}
+
+// coverTree records, for the compacted target cells and their ancestors no
+// coarser than the minimum resolution, how many target cells each holds and
+// which of its children hold any.
+type coverTree struct {
+	minRes   int
+	maxRes   int
+	roots    []Cell
+	targets  map[Cell]int
+	children map[Cell][]Cell
+}
+
+func newCoverTree(polygon GeoPolygon, minRes, maxRes int, mode ContainmentMode) (*coverTree, error) {
+	t := &coverTree{
+		minRes:   minRes,
+		maxRes:   maxRes,
+		targets:  make(map[Cell]int),
+		children: make(map[Cell][]Cell),
+	}
+
+	for c, err := range PolygonToCompactCellsSeq(polygon, maxRes, mode) {
+		if err != nil {
+			return nil, err
+		}
+
+		if c.Resolution() >= minRes {
+			t.add(c)
+			continue
+		}
+
+		for child := range c.ChildrenSeq(minRes) {
+			t.add(child)
+		}
+	}
+
+	for _, children := range t.children {
+		slices.Sort(children)
+	}
+
+	return t, nil
+}
+
+// add records c, whose descendants at the maximum resolution are all target
+// cells, and links it to its ancestors.
+func (t *coverTree) add(c Cell) {
+	n := descendantCount(c, t.maxRes)
+	t.targets[c] += n
+
+	// A cell holding exactly n targets was first reached through c.
+	child := c
+	for r := c.Resolution() - 1; r >= t.minRes; r-- {
+		parent := parentBits(c, r)
+		if t.targets[child] == n {
+			t.children[parent] = append(t.children[parent], child)
+		}
+		t.targets[parent] += n
+		child = parent
+	}
+
+	if t.targets[child] == n {
+		t.roots = append(t.roots, child)
+	}
+}
+
+// waste returns the number of cells at the maximum resolution under c that
+// are not target cells.
+func (t *coverTree) waste(c Cell) int {
+	return descendantCount(c, t.maxRes) - t.targets[c]
+}
+
+// push queues c for splitting if doing so reduces over-coverage.
+func (t *coverTree) push(q *coverQueue, c Cell) {
+	waste := t.waste(c)
+	if waste == 0 {
+		return
+	}
+
+	children := t.children[c]
+	for _, child := range children {
+		waste -= t.waste(child)
+	}
+
+	heap.Push(q, coverCandidate{cell: c, gain: waste, cost: len(children) - 1})
+}
+
+// descendantCount returns the number of descendants of the cell at
+// resolution, which must not be coarser than the cell.
+func descendantCount(c Cell, resolution int) int {
+	n := resolution - c.Resolution()
+	if c.IsPentagon() {
+		return 1 + 5*(pow7[n]-1)/6 //nolint:mnd // one of the 6 children is a pentagon
+	}
+
+	return pow7[n]
+}
+
+// coverCandidate is a cell that can be replaced by its children holding
+// target cells, removing gain cells of over-coverage for cost extra cells.
+type coverCandidate struct {
+	cell Cell
+	gain int
+	cost int
+}
+
+// coverQueue is a max-heap of candidates ordered by gain per cost.
+type coverQueue []coverCandidate
+
+func (q coverQueue) Len() int { return len(q) }
+
+func (q coverQueue) Less(i, j int) bool {
+	// Compare gain/cost without division; splits that add no cells come first.
+	a, b := q[i], q[j]
+	if lhs, rhs := a.gain*b.cost, b.gain*a.cost; lhs != rhs {
+		return lhs > rhs
+	}
+
+	return a.cell < b.cell
+}
+
+func (q coverQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+
+func (q *coverQueue) Push(x any) {
+	c, ok := x.(coverCandidate)
+	if !ok {
+		panic(fmt.Sprintf("coverQueue.Push: got %T, want coverCandidate", x))
+	}
+
+	*q = append(*q, c)
+}
+
+func (q *coverQueue) Pop() any {
+	old := *q
+	x := old[len(old)-1]
+	*q = old[:len(old)-1]
+
+	return x
+}
+
+// pop removes and returns the candidate with the highest gain per cost.
+func (q *coverQueue) pop() coverCandidate {
+	c, _ := heap.Pop(q).(coverCandidate)
+	return c
+}
@@ -0,0 +1,124 @@
+/*
+ * Copyright 2026 Uber Technologies, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *         http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package h3
+
+import "testing"
+
+func TestRegionCoverer_Covering(t *testing.T) {
+	t.Parallel()
+
+	targets, err := PolygonToCellsExperimental(validGeoPolygonHoles, 9, ContainmentOverlapping)
+	assertNoErr(t, err)
+
+	// overCoverage returns the number of res 9 cells covered beyond the
+	// targets, checking every target is covered exactly once.
+	overCoverage := func(covering []Cell) int {
+		t.Helper()
+
+		idx, err := NewContainmentIndex(covering)
+		assertNoErr(t, err)
+
+		var total int
+		for _, c := range covering {
+			assertEqual(t, 1, len(idx.Intersecting(c)), c)
+			total += descendantCount(c, 9)
+		}
+		for _, c := range targets {
+			assertTrue(t, idx.Contains(c))
+		}
+
+		return total - len(targets)
+	}
+
+	t.Run("unlimited", func(t *testing.T) {
+		t.Parallel()
+
+		rc := RegionCoverer{MinResolution: 0, MaxResolution: 9, Mode: ContainmentOverlapping}
+		covering, err := rc.Covering(validGeoPolygonHoles)
+		assertNoErr(t, err)
+		assertEqualCells(t, NewCellSet(targets...).Compact().Cells(), covering)
+		assertEqual(t, 0, overCoverage(covering))
+	})
+
+	t.Run("budget", func(t *testing.T) {
+		t.Parallel()
+
+		previous := -1
+		for _, maxCells := range []int{200, 50, 20, 8} {
+			rc := RegionCoverer{MinResolution: 4, MaxResolution: 9, MaxCells: maxCells, Mode: ContainmentOverlapping}
+			covering, err := rc.Covering(validGeoPolygonHoles)
+			assertNoErr(t, err)
+			assertTrue(t, len(covering) <= maxCells)
+			for _, c := range covering {
+				assertTrue(t, c.Resolution() >= 4)
+			}
+
+			waste := overCoverage(covering)
+			assertTrue(t, waste >= previous)
+			previous = waste
+		}
+	})
+
+	t.Run("min resolution wins over budget", func(t *testing.T) {
+		t.Parallel()
+
+		rc := RegionCoverer{MinResolution: 8, MaxResolution: 9, MaxCells: 1, Mode: ContainmentOverlapping}
+		covering, err := rc.Covering(validGeoPolygonHoles)
+		assertNoErr(t, err)
+		assertTrue(t, len(covering) > 1)
+		for _, c := range covering {
+			assertEqual(t, 8, c.Resolution())
+		}
+	})
+
+	t.Run("fine max resolution", func(t *testing.T) {
+		t.Parallel()
+
+		// About 100,000 target cells, which are never listed individually.
+		rc := RegionCoverer{MinResolution: 0, MaxResolution: 11, MaxCells: 16, Mode: ContainmentOverlapping}
+		covering, err := rc.Covering(validGeoPolygonHoles)
+		assertNoErr(t, err)
+		assertTrue(t, len(covering) <= 16)
+
+		idx, err := NewContainmentIndex(covering)
+		assertNoErr(t, err)
+		for c, err := range PolygonToCompactCellsSeq(validGeoPolygonHoles, 11, ContainmentOverlapping) {
+			assertNoErr(t, err)
+			assertTrue(t, idx.Contains(c))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		t.Parallel()
+
+		for _, rc := range []RegionCoverer{
+			{MinResolution: -1, MaxResolution: 9},
+			{MinResolution: 9, MaxResolution: 8},
+			{MinResolution: 0, MaxResolution: 16},
+		} {
+			_, err := rc.Covering(validGeoPolygonHoles)
+			assertErrIs(t, err, ErrResolutionDomain)
+		}
+
+		_, err := RegionCoverer{MaxResolution: 9, Mode: ContainmentInvalid}.Covering(validGeoPolygonHoles)
+		assertErrIs(t, err, ErrOptionInvalid)
+
+		covering, err := RegionCoverer{MaxResolution: 9}.Covering(GeoPolygon{})
+		assertNoErr(t, err)
+		assertEqual(t, 0, len(covering))
+	})
+}
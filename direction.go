@@ -0,0 +1,103 @@
+/*
+ * Copyright 2026 Uber Technologies, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *         http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package h3
+
+/*
+#include <h3_h3api.h>
+#include <h3_algos.h>
+#include <h3_coordijk.h>
+*/
+import "C"
+
+// Directions from a cell towards its neighbors, named after the unit vectors
+// of the [IJK coordinate system]. They have the same values as the indexing
+// digits of the neighbors' center children.
+//
+// Pentagons have no neighbor in KAxesDirection.
+//
+// [IJK coordinate system]: https://h3geo.org/docs/core-library/coordsystems
+const (
+	CenterDirection  Direction = C.CENTER_DIGIT
+	KAxesDirection   Direction = C.K_AXES_DIGIT
+	JAxesDirection   Direction = C.J_AXES_DIGIT
+	JKAxesDirection  Direction = C.JK_AXES_DIGIT
+	IAxesDirection   Direction = C.I_AXES_DIGIT
+	IKAxesDirection  Direction = C.IK_AXES_DIGIT
+	IJAxesDirection  Direction = C.IJ_AXES_DIGIT
+	InvalidDirection Direction = C.INVALID_DIGIT
+)
+
+// Direction is a direction from a cell towards one of its neighbors.
+type Direction int
+
+// IsValid returns whether the direction points towards a neighbor, that is,
+// it is neither CenterDirection nor out of range.
+func (d Direction) IsValid() bool {
+	return d > CenterDirection && d < InvalidDirection
+}
+
+// Neighbor returns the neighbor of the cell in the given direction.
+//
+// CenterDirection returns the cell itself. ErrPentagon is returned for
+// KAxesDirection from a pentagon, which has no neighbor in that direction.
+//
+// Directions are relative to the cell's own coordinate system, so stepping
+// in the same direction repeatedly does not follow a straight line where the
+// path crosses icosahedron faces.
+func (c Cell) Neighbor(dir Direction) (Cell, error) {
+	switch {
+	case !c.IsValid():
+		return 0, toOpErr(C.E_CELL_INVALID, "Cell.Neighbor", c, dir)
+	case dir < CenterDirection || dir >= InvalidDirection:
+		return 0, toOpErr(C.E_DOMAIN, "Cell.Neighbor", c, dir)
+	case dir == KAxesDirection && c.IsPentagon():
+		return 0, toOpErr(C.E_PENTAGON, "Cell.Neighbor", c, dir)
+	}
+
+	var (
+		out       C.H3Index
+		rotations C.int
+	)
+	errC := C.h3NeighborRotations(C.H3Index(c), C.Direction(dir), &rotations, &out)
+
+	return Cell(out), toOpErr(errC, "Cell.Neighbor", c, dir)
+}
+
+// DirectionTo returns the direction from the cell to other, the inverse of
+// Neighbor. ErrNotNeighbors is returned if the cells are not neighbors.
+func (c Cell) DirectionTo(other Cell) (Direction, error) {
+	if !c.IsValid() {
+		return InvalidDirection, toOpErr(C.E_CELL_INVALID, "Cell.DirectionTo", c, other)
+	}
+
+	dir := Direction(C.directionForNeighbor(C.H3Index(c), C.H3Index(other)))
+	if !dir.IsValid() {
+		return InvalidDirection, toOpErr(C.E_NOT_NEIGHBORS, "Cell.DirectionTo", c, other)
+	}
+
+	return dir, nil
+}
+
+// Direction returns the direction from the origin of the edge to its
+// destination, or InvalidDirection if the edge is not valid.
+func (e DirectedEdge) Direction() Direction {
+	if !e.IsValid() {
+		return InvalidDirection
+	}
+
+	return Direction(DecomposeIndex(e).Reserved)
+}
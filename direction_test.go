@@ -0,0 +1,113 @@
+/*
+ * Copyright 2026 Uber Technologies, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *         http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package h3
+
+import "testing"
+
+func TestNeighbor(t *testing.T) {
+	t.Parallel()
+
+	for _, c := range []Cell{validCell, pentagonCell, lineStartCell} {
+		disk, err := c.GridDisk(1)
+		assertNoErr(t, err)
+
+		self, err := c.Neighbor(CenterDirection)
+		assertNoErr(t, err)
+		assertEqual(t, c, self)
+
+		seen := map[Cell]bool{}
+		for dir := KAxesDirection; dir < InvalidDirection; dir++ {
+			n, err := c.Neighbor(dir)
+			if c.IsPentagon() && dir == KAxesDirection {
+				assertErrIs(t, err, ErrPentagon)
+				continue
+			}
+			assertNoErr(t, err)
+			assertCellIn(t, n, disk)
+			assertFalse(t, n == c)
+			assertFalse(t, seen[n])
+			seen[n] = true
+
+			back, err := c.DirectionTo(n)
+			assertNoErr(t, err)
+			assertEqual(t, dir, back)
+		}
+		assertEqual(t, len(disk)-1, len(seen))
+	}
+
+	_, err := validCell.Neighbor(InvalidDirection)
+	assertErrIs(t, err, ErrDomain)
+
+	_, err = validCell.Neighbor(-1)
+	assertErrIs(t, err, ErrDomain)
+
+	_, err = Cell(0).Neighbor(IAxesDirection)
+	assertErrIs(t, err, ErrCellInvalid)
+
+	// Res 0 pentagons are handled like finer ones.
+	res0Pentagons, err := Pentagons(0)
+	assertNoErr(t, err)
+	_, err = res0Pentagons[0].Neighbor(KAxesDirection)
+	assertErrIs(t, err, ErrPentagon)
+}
+
+func TestDirectionTo(t *testing.T) {
+	t.Parallel()
+
+	_, err := validCell.DirectionTo(validCell)
+	assertErrIs(t, err, ErrNotNeighbors)
+
+	_, err = lineStartCell.DirectionTo(lineEndCell)
+	assertErrIs(t, err, ErrNotNeighbors)
+
+	_, err = Cell(0).DirectionTo(validCell)
+	assertErrIs(t, err, ErrCellInvalid)
+}
+
+func TestDirectedEdgeDirection(t *testing.T) {
+	t.Parallel()
+
+	for _, c := range []Cell{validCell, pentagonCell} {
+		edges, err := c.DirectedEdges()
+		assertNoErr(t, err)
+
+		for _, e := range edges {
+			dir := e.Direction()
+			assertTrue(t, dir.IsValid())
+
+			dest, err := e.Destination()
+			assertNoErr(t, err)
+
+			n, err := c.Neighbor(dir)
+			assertNoErr(t, err)
+			assertEqual(t, dest, n)
+		}
+	}
+
+	assertEqual(t, InvalidDirection, DirectedEdge(0).Direction())
+	assertEqual(t, InvalidDirection, DirectedEdge(validCell).Direction())
+}
+
+func TestDirectionIsValid(t *testing.T) {
+	t.Parallel()
+
+	assertFalse(t, CenterDirection.IsValid())
+	assertTrue(t, KAxesDirection.IsValid())
+	assertTrue(t, IJAxesDirection.IsValid())
+	assertFalse(t, InvalidDirection.IsValid())
+	assertFalse(t, Direction(-1).IsValid())
+}
@@ -0,0 +1,233 @@
+/*
+ * Copyright 2026 Uber Technologies, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *         http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package h3
+
+/*
+#include <h3_h3api.h>
+*/
+import "C"
+
+import "encoding"
+
+// UndirectedEdge is an Index that identifies the edge shared by two
+// neighboring cells, regardless of direction.
+//
+// H3 Core has no undirected edge functions, so the index is defined by this
+// package: it is the directed edge from the lower of the two cell indexes to
+// the higher, with the mode set to UndirectedEdgeMode. Every edge therefore
+// has exactly one UndirectedEdge, which makes it suitable as a map key.
+type UndirectedEdge int64
+
+// compile time checks that ensure interface implementation
+var (
+	_ encoding.TextMarshaler   = (*UndirectedEdge)(nil)
+	_ encoding.TextUnmarshaler = (*UndirectedEdge)(nil)
+)
+
+// UndirectedEdge returns the UndirectedEdge shared by this Cell and other.
+// The order of the cells does not matter.
+func (c Cell) UndirectedEdge(other Cell) (UndirectedEdge, error) {
+	lo, hi := min(c, other), max(c, other)
+
+	var out C.H3Index
+	errC := C.cellsToDirectedEdge(C.H3Index(lo), C.H3Index(hi), &out)
+	if err := toOpErr(errC, "Cell.UndirectedEdge", c, other); err != nil {
+		return 0, err
+	}
+
+	return UndirectedEdge(setMode(uint64(out), UndirectedEdgeMode)), nil
+}
+
+// UndirectedEdge returns the UndirectedEdge for this directed edge. A
+// directed edge and its reverse share the same UndirectedEdge.
+func (e DirectedEdge) UndirectedEdge() (UndirectedEdge, error) {
+	if !e.IsValid() {
+		return 0, toOpErr(C.E_DIR_EDGE_INVALID, "DirectedEdge.UndirectedEdge", e)
+	}
+
+	cells, err := e.Cells()
+	if err != nil {
+		return 0, err
+	}
+
+	return cells[0].UndirectedEdge(cells[1])
+}
+
+// UndirectedEdgeFromString returns an UndirectedEdge from a string. Should
+// call e.IsValid() to check if the UndirectedEdge is valid before using it.
+func UndirectedEdgeFromString(s string) UndirectedEdge {
+	return UndirectedEdge(IndexFromString(s))
+}
+
+// IsValid determines if the undirected edge is valid, including whether it is
+// in its canonical form.
+func (e UndirectedEdge) IsValid() bool {
+	return isValidUndirectedEdge(uint64(e))
+}
+
+// Resolution returns the resolution of the edge.
+func (e UndirectedEdge) Resolution() int {
+	return resolution(e)
+}
+
+// DirectedEdges returns the two directed edges along this edge. The first one
+// starts at the lower of the two cell indexes.
+func (e UndirectedEdge) DirectedEdges() ([]DirectedEdge, error) {
+	cells, err := e.Cells()
+	if err != nil {
+		return nil, err
+	}
+
+	reverse, err := cells[1].DirectedEdge(cells[0])
+	if err != nil {
+		return nil, err
+	}
+
+	return []DirectedEdge{e.directedEdge(), reverse}, nil
+}
+
+// Cells returns the two cells sharing this edge, lower index first.
+func (e UndirectedEdge) Cells() ([]Cell, error) {
+	if !e.IsValid() {
+		return nil, toOpErr(C.E_UNDIR_EDGE_INVALID, "UndirectedEdge.Cells", e)
+	}
+
+	out := make([]C.H3Index, numEdgeCells)
+	errC := C.directedEdgeToCells(C.H3Index(e.directedEdge()), &out[0])
+	if err := toOpErr(errC, "UndirectedEdge.Cells", e); err != nil {
+		return nil, err
+	}
+
+	return cellsFromC(out, false, false), nil
+}
+
+// Boundary provides the coordinates of the boundary of the edge, the line
+// shared by its two cells. There may be more than 2 coordinates to account for
+// crossing faces.
+func (e UndirectedEdge) Boundary() (CellBoundary, error) {
+	if !e.IsValid() {
+		return nil, toOpErr(C.E_UNDIR_EDGE_INVALID, "UndirectedEdge.Boundary", e)
+	}
+
+	var out C.CellBoundary
+	errC := C.directedEdgeToBoundary(C.H3Index(e.directedEdge()), &out)
+	if err := toOpErr(errC, "UndirectedEdge.Boundary", e); err != nil {
+		return nil, err
+	}
+
+	return cellBndryFromC(&out), nil
+}
+
+// LengthRads returns the exact length of the edge in radians.
+func (e UndirectedEdge) LengthRads() (float64, error) {
+	if !e.IsValid() {
+		return 0, toOpErr(C.E_UNDIR_EDGE_INVALID, "UndirectedEdge.LengthRads", e)
+	}
+
+	var out C.double
+	errC := C.edgeLengthRads(C.H3Index(e.directedEdge()), &out)
+
+	return float64(out), toOpErr(errC, "UndirectedEdge.LengthRads", e)
+}
+
+// LengthKm returns the exact length of the edge in kilometers.
+func (e UndirectedEdge) LengthKm() (float64, error) {
+	if !e.IsValid() {
+		return 0, toOpErr(C.E_UNDIR_EDGE_INVALID, "UndirectedEdge.LengthKm", e)
+	}
+
+	var out C.double
+	errC := C.edgeLengthKm(C.H3Index(e.directedEdge()), &out)
+
+	return float64(out), toOpErr(errC, "UndirectedEdge.LengthKm", e)
+}
+
+// LengthM returns the exact length of the edge in meters.
+func (e UndirectedEdge) LengthM() (float64, error) {
+	if !e.IsValid() {
+		return 0, toOpErr(C.E_UNDIR_EDGE_INVALID, "UndirectedEdge.LengthM", e)
+	}
+
+	var out C.double
+	errC := C.edgeLengthM(C.H3Index(e.directedEdge()), &out)
+
+	return float64(out), toOpErr(errC, "UndirectedEdge.LengthM", e)
+}
+
+// IndexDigit returns an [indexing digit] of the edge.
+//
+// [indexing digit]: https://h3geo.org/docs/library/index/cell
+func (e UndirectedEdge) IndexDigit(resolution int) (int, error) {
+	return indexDigit(e, resolution)
+}
+
+// IndexParts splits the undirected edge into its bit layout fields.
+func (e UndirectedEdge) IndexParts() IndexParts {
+	return DecomposeIndex(e)
+}
+
+// String returns the string representation.
+func (e UndirectedEdge) String() string {
+	return indexToString(e)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (e UndirectedEdge) MarshalText() ([]byte, error) {
+	return marshalText(e)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface. It
+// returns a *ParseError describing the problem if text is not a valid
+// undirected edge, as ParseUndirectedEdge does.
+func (e *UndirectedEdge) UnmarshalText(text []byte) error {
+	parsed, err := ParseUndirectedEdge(string(text))
+	if err != nil {
+		return err
+	}
+	*e = parsed
+
+	return nil
+}
+
+// directedEdge returns the directed edge from the lower cell, which H3 Core
+// functions accept in place of the undirected edge.
+func (e UndirectedEdge) directedEdge() DirectedEdge {
+	return DirectedEdge(setMode(uint64(e), DirectedEdgeMode))
+}
+
+func isValidUndirectedEdge(h uint64) bool {
+	if IndexMode(h>>modeOffset&modeMask) != UndirectedEdgeMode {
+		return false
+	}
+
+	e := DirectedEdge(setMode(h, DirectedEdgeMode))
+	if !e.IsValid() {
+		return false
+	}
+
+	out := make([]C.H3Index, numEdgeCells)
+	if C.directedEdgeToCells(C.H3Index(e), &out[0]) != C.E_SUCCESS {
+		return false
+	}
+
+	return out[0] < out[1]
+}
+
+// setMode returns the index with its mode replaced.
+func setMode(h uint64, mode IndexMode) uint64 {
+	return h&^(modeMask<<modeOffset) | uint64(mode)<<modeOffset
+}
@@ -0,0 +1,152 @@
+/*
+ * Copyright 2026 Uber Technologies, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *         http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package h3
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestUndirectedEdge(t *testing.T) {
+	t.Parallel()
+
+	for _, c := range []Cell{validCell, pentagonCell} {
+		directed, err := c.DirectedEdges()
+		assertNoErr(t, err)
+
+		for _, de := range directed {
+			dest, err := de.Destination()
+			assertNoErr(t, err)
+
+			e, err := c.UndirectedEdge(dest)
+			assertNoErr(t, err)
+			assertTrue(t, e.IsValid())
+			assertTrue(t, IsValidIndex(e))
+			assertEqual(t, UndirectedEdgeMode, e.IndexParts().Mode)
+			assertEqual(t, c.Resolution(), e.Resolution())
+
+			reverse, err := dest.UndirectedEdge(c)
+			assertNoErr(t, err)
+			assertEqual(t, e, reverse)
+
+			fromDirected, err := de.UndirectedEdge()
+			assertNoErr(t, err)
+			assertEqual(t, e, fromDirected)
+
+			cells, err := e.Cells()
+			assertNoErr(t, err)
+			assertEqual(t, 2, len(cells))
+			assertEqual(t, min(c, dest), cells[0])
+			assertEqual(t, max(c, dest), cells[1])
+
+			edges, err := e.DirectedEdges()
+			assertNoErr(t, err)
+			assertEqual(t, 2, len(edges))
+			for _, de := range edges {
+				u, err := de.UndirectedEdge()
+				assertNoErr(t, err)
+				assertEqual(t, e, u)
+			}
+			assertCellIn(t, Cell(de), []Cell{Cell(edges[0]), Cell(edges[1])})
+		}
+	}
+}
+
+func TestUndirectedEdgeGeometry(t *testing.T) {
+	t.Parallel()
+
+	e, err := validEdge.UndirectedEdge()
+	assertNoErr(t, err)
+
+	boundary, err := e.Boundary()
+	assertNoErr(t, err)
+	expectedBoundary, _ := validEdge.Boundary()
+	assertEqual(t, len(expectedBoundary), len(boundary))
+
+	rads, err := e.LengthRads()
+	assertNoErr(t, err)
+	expectedRads, _ := EdgeLengthRads(validEdge)
+	assertEqualEps(t, expectedRads, rads)
+
+	km, err := e.LengthKm()
+	assertNoErr(t, err)
+	expectedKm, _ := EdgeLengthKm(validEdge)
+	assertEqualEps(t, expectedKm, km)
+
+	m, err := e.LengthM()
+	assertNoErr(t, err)
+	assertEqualEps(t, km*1000, m)
+}
+
+func TestUndirectedEdgeInvalid(t *testing.T) {
+	t.Parallel()
+
+	e, _ := validEdge.UndirectedEdge()
+	cells, _ := e.Cells()
+	upper, _ := cells[1].DirectedEdge(cells[0])
+
+	invalid := []UndirectedEdge{
+		0,
+		UndirectedEdge(validEdge),
+		UndirectedEdge(validCell),
+		// Not canonical: starts at the higher cell.
+		UndirectedEdge(setMode(uint64(upper), UndirectedEdgeMode)),
+	}
+
+	for _, e := range invalid {
+		assertFalse(t, e.IsValid())
+
+		_, err := e.Cells()
+		assertErrIs(t, err, ErrUndirectedEdgeInvalid)
+		_, err = e.DirectedEdges()
+		assertErrIs(t, err, ErrUndirectedEdgeInvalid)
+		_, err = e.Boundary()
+		assertErrIs(t, err, ErrUndirectedEdgeInvalid)
+		_, err = e.LengthRads()
+		assertErrIs(t, err, ErrUndirectedEdgeInvalid)
+		_, err = e.LengthKm()
+		assertErrIs(t, err, ErrUndirectedEdgeInvalid)
+		_, err = e.LengthM()
+		assertErrIs(t, err, ErrUndirectedEdgeInvalid)
+	}
+
+	assertFalse(t, IsValidIndex(invalid[3]))
+
+	_, err := validCell.UndirectedEdge(lineStartCell)
+	assertErrIs(t, err, ErrNotNeighbors)
+
+	_, err = DirectedEdge(0).UndirectedEdge()
+	assertErrIs(t, err, ErrDirectedEdgeInvalid)
+}
+
+func TestUndirectedEdgeText(t *testing.T) {
+	t.Parallel()
+
+	e, _ := validEdge.UndirectedEdge()
+	assertEqual(t, e, UndirectedEdgeFromString(e.String()))
+
+	b, err := json.Marshal(map[UndirectedEdge]int{e: 1})
+	assertNoErr(t, err)
+	assertEqual(t, `{"`+e.String()+`":1}`, string(b))
+
+	var decoded map[UndirectedEdge]int
+	assertNoErr(t, json.Unmarshal(b, &decoded))
+	assertEqual(t, 1, decoded[e])
+
+	var invalid UndirectedEdge
+	assertErr(t, invalid.UnmarshalText([]byte(validEdge.String())))
+}
@@ -0,0 +1,85 @@
+/*
+ * Copyright 2026 Uber Technologies, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *         http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package h3
+
+/*
+#include <h3_h3api.h>
+*/
+import "C"
+
+import (
+	"fmt"
+	"strings"
+)
+
+// H3Error is an error reported by H3 Core, annotated with the function that
+// failed and the arguments it was called with.
+//
+// An H3Error matches the sentinel error for its code, such as ErrCellInvalid,
+// with errors.Is. Codes not recognized by this package match ErrFailed.
+type H3Error struct {
+	// Code is the numeric H3 Core error code.
+	Code uint32
+	// Op is the name of the function that failed, such as "GridDisk" or
+	// "Cell.Parent".
+	Op string
+	// Args are the arguments of the failed call that identify the offending
+	// input, such as the cell, resolution or k.
+	Args []any
+}
+
+// Error returns the failed call and the H3 Core description of the error
+// code, for example "GridDisk(850dab63fffffff, -1): Argument was outside of
+// acceptable range".
+func (e *H3Error) Error() string {
+	var b strings.Builder
+
+	b.WriteString(e.Op)
+	b.WriteByte('(')
+
+	for i, arg := range e.Args {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		fmt.Fprint(&b, arg)
+	}
+
+	b.WriteString("): ")
+	b.WriteString(e.Description())
+
+	return b.String()
+}
+
+// Description returns the H3 Core description of the error code.
+func (e *H3Error) Description() string {
+	return C.GoString(C.describeH3Error(C.H3Error(e.Code)))
+}
+
+// Unwrap returns the sentinel error for the error code.
+func (e *H3Error) Unwrap() error {
+	return toErr(C.uint32_t(e.Code))
+}
+
+// toOpErr converts H3 error codes to an *H3Error recording the failed
+// operation and its arguments. It returns nil on success.
+func toOpErr(errC C.uint32_t, op string, args ...any) error {
+	if errC == C.E_SUCCESS {
+		return nil
+	}
+
+	return &H3Error{Code: uint32(errC), Op: op, Args: args}
+}
@@ -0,0 +1,76 @@
+/*
+ * Copyright 2026 Uber Technologies, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *         http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package h3
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestH3Error(t *testing.T) {
+	t.Parallel()
+
+	t.Run("cell", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := validCell.Parent(-1)
+
+		var h3Err *H3Error
+		assertTrue(t, errors.As(err, &h3Err))
+		assertEqual(t, uint32(4), h3Err.Code)
+		assertEqual(t, "Cell.Parent", h3Err.Op)
+		assertEqual(t, 2, len(h3Err.Args))
+		assertEqual(t, "Resolution argument was outside of acceptable range", h3Err.Description())
+		assertEqual(t,
+			"Cell.Parent(850dab63fffffff, -1): Resolution argument was outside of acceptable range",
+			err.Error(),
+		)
+		assertErrIs(t, err, ErrResolutionDomain)
+		assertFalse(t, errors.Is(err, ErrCellInvalid))
+	})
+
+	t.Run("go side", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := GridRing(validCell, -1)
+		assertEqual(t, "GridRing(850dab63fffffff, -1): Argument was outside of acceptable range", err.Error())
+		assertErrIs(t, err, ErrDomain)
+	})
+
+	t.Run("latlng", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := LatLngToCell(validLatLng1, MaxResolution+1)
+		assertEqual(t,
+			"LatLngToCell((67.15093, -168.39089), 16): Resolution argument was outside of acceptable range",
+			err.Error(),
+		)
+	})
+
+	t.Run("unknown code", func(t *testing.T) {
+		t.Parallel()
+
+		err := toOpErr(999, "Op")
+		assertEqual(t, "Op(): Invalid error code", err.Error())
+		assertErrIs(t, err, ErrFailed)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		assertNil(t, toOpErr(0, "Op"))
+	})
+}
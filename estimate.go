@@ -0,0 +1,104 @@
+/*
+ * Copyright 2026 Uber Technologies, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *         http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package h3
+
+/*
+#include <h3_h3api.h>
+#include <h3_bbox.h>
+*/
+import "C"
+
+// MaxPolygonToCellsSize returns an upper bound on the number of cells
+// PolygonToCells produces for polygon at resolution. It is the size of the
+// buffer PolygonToCells allocates.
+//
+// The bound is derived from the bounding box of the outer loop only, so it is
+// cheap to compute but can overestimate by several times for thin, diagonal or
+// holed polygons.
+func MaxPolygonToCellsSize(polygon GeoPolygon, resolution int) (int, error) {
+	if len(polygon.GeoLoop) == 0 {
+		return 0, nil
+	}
+	cpoly := allocCGeoPolygon(polygon)
+
+	defer freeCGeoPolygon(&cpoly)
+
+	var out C.int64_t
+	errC := C.maxPolygonToCellsSize(&cpoly, C.int(resolution), 0, &out)
+
+	return int(out), toOpErr(errC, "MaxPolygonToCellsSize", resolution)
+}
+
+// MaxPolygonToCellsSizeExperimental returns an upper bound on the number of
+// cells PolygonToCellsExperimental produces for polygon at resolution with any
+// containment mode. mode is validated but does not otherwise affect the
+// result.
+//
+// The bound is computed by running a coarse polygon fill with
+// ContainmentOverlappingBbox, so it is much tighter than MaxPolygonToCellsSize
+// but costs roughly as much as filling the polygon at a coarser resolution.
+func MaxPolygonToCellsSizeExperimental(polygon GeoPolygon, resolution int, mode ContainmentMode) (int, error) {
+	if len(polygon.GeoLoop) == 0 {
+		return 0, nil
+	}
+	cpoly := allocCGeoPolygon(polygon)
+
+	defer freeCGeoPolygon(&cpoly)
+
+	var out C.int64_t
+	errC := C.maxPolygonToCellsSizeExperimental(&cpoly, C.int(resolution), C.uint32_t(mode), &out)
+
+	return int(out), toOpErr(errC, "MaxPolygonToCellsSizeExperimental", resolution, mode)
+}
+
+// BBoxCellsEstimate returns an estimate of the number of cells at resolution
+// needed to fill the bounding box.
+//
+// The estimate assumes every cell is as small as the most distorted hexagon at
+// resolution, so it usually exceeds the actual count, but it is not a strict
+// bound. It is always at least 1. ErrFailed is returned for a box with zero
+// width or height.
+func BBoxCellsEstimate(b BBox, resolution int) (int, error) {
+	var out C.int64_t
+
+	cb := b.toC()
+	errC := C.bboxHexEstimate(&cb, C.int(resolution), &out)
+
+	return int(out), toOpErr(errC, "BBoxCellsEstimate", b, resolution)
+}
+
+// CellsEstimate returns an estimate of the number of cells at resolution
+// needed to fill the bounding box. See BBoxCellsEstimate.
+func (b BBox) CellsEstimate(resolution int) (int, error) {
+	return BBoxCellsEstimate(b, resolution)
+}
+
+// LineCellsEstimate returns an estimate of the number of cells at resolution
+// needed to trace the great circle segment from origin to destination.
+//
+// The estimate divides the segment length by the diameter of the most distorted
+// cell at resolution, so it is not a strict bound: a segment crossing cells
+// diagonally or near their vertexes can touch more cells. It is always at
+// least 1.
+func LineCellsEstimate(origin, destination LatLng, resolution int) (int, error) {
+	var out C.int64_t
+
+	co, cd := origin.toC(), destination.toC()
+	errC := C.lineHexEstimate(&co, &cd, C.int(resolution), &out)
+
+	return int(out), toOpErr(errC, "LineCellsEstimate", origin, destination, resolution)
+}
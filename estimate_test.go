@@ -0,0 +1,105 @@
+/*
+ * Copyright 2026 Uber Technologies, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *         http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package h3
+
+import "testing"
+
+func TestMaxPolygonToCellsSize(t *testing.T) {
+	t.Parallel()
+
+	for _, res := range []int{6, 8, 9} {
+		cells, err := PolygonToCells(validGeoPolygonHoles, res)
+		assertNoErr(t, err)
+
+		size, err := MaxPolygonToCellsSize(validGeoPolygonHoles, res)
+		assertNoErr(t, err)
+		assertTrue(t, size >= len(cells))
+	}
+
+	size, err := MaxPolygonToCellsSize(GeoPolygon{}, 6)
+	assertNoErr(t, err)
+	assertEqual(t, 0, size)
+
+	_, err = MaxPolygonToCellsSize(validGeoPolygonHoles, -1)
+	assertErrIs(t, err, ErrResolutionDomain)
+}
+
+func TestMaxPolygonToCellsSizeExperimental(t *testing.T) {
+	t.Parallel()
+
+	for _, mode := range []ContainmentMode{
+		ContainmentCenter,
+		ContainmentFull,
+		ContainmentOverlapping,
+		ContainmentOverlappingBbox,
+	} {
+		cells, err := PolygonToCellsExperimental(validGeoPolygonHoles, 9, mode)
+		assertNoErr(t, err)
+
+		size, err := MaxPolygonToCellsSizeExperimental(validGeoPolygonHoles, 9, mode)
+		assertNoErr(t, err)
+		assertTrue(t, size >= len(cells))
+	}
+
+	size, err := MaxPolygonToCellsSizeExperimental(GeoPolygon{}, 6, ContainmentCenter)
+	assertNoErr(t, err)
+	assertEqual(t, 0, size)
+
+	_, err = MaxPolygonToCellsSizeExperimental(validGeoPolygonHoles, 6, ContainmentInvalid)
+	assertErrIs(t, err, ErrOptionInvalid)
+}
+
+func TestBBoxCellsEstimate(t *testing.T) {
+	t.Parallel()
+
+	b := validGeoLoop.BBox()
+	cells, _ := PolygonToCells(GeoPolygon{GeoLoop: validGeoLoop}, 9)
+
+	estimate, err := b.CellsEstimate(9)
+	assertNoErr(t, err)
+	assertTrue(t, estimate >= len(cells))
+
+	estimate, err = BBoxCellsEstimate(b, 0)
+	assertNoErr(t, err)
+	assertEqual(t, 1, estimate)
+
+	_, err = BBoxCellsEstimate(NewBBox(10, 10, 20, 0), 9)
+	assertErrIs(t, err, ErrFailed)
+
+	_, err = BBoxCellsEstimate(b, -1)
+	assertErrIs(t, err, ErrResolutionDomain)
+}
+
+func TestLineCellsEstimate(t *testing.T) {
+	t.Parallel()
+
+	start, _ := lineStartCell.LatLng()
+	end, _ := lineEndCell.LatLng()
+	dist, _ := lineStartCell.GridDistance(lineEndCell)
+
+	estimate, err := LineCellsEstimate(start, end, lineStartCell.Resolution())
+	assertNoErr(t, err)
+	assertTrue(t, estimate > dist/2)
+	assertTrue(t, estimate < dist*2)
+
+	estimate, err = LineCellsEstimate(start, start, 9)
+	assertNoErr(t, err)
+	assertEqual(t, 1, estimate)
+
+	_, err = LineCellsEstimate(start, end, MaxResolution+1)
+	assertErrIs(t, err, ErrResolutionDomain)
+}
@@ -0,0 +1,359 @@
+/*
+ * Copyright 2026 Uber Technologies, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *         http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package h3
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// GeoJSONIndexProperty is the name of the Feature property holding the index
+// of a cell, as a hexadecimal string.
+const GeoJSONIndexProperty = "index"
+
+// ErrInvalidGeoJSON is returned, wrapped with details, when decoding GeoJSON
+// that is malformed or of an unexpected type.
+var ErrInvalidGeoJSON = errors.New("invalid GeoJSON")
+
+// GeoJSON types as defined in RFC 7946.
+const (
+	geoJSONPoint              = "Point"
+	geoJSONPolygon            = "Polygon"
+	geoJSONMultiPolygon       = "MultiPolygon"
+	geoJSONGeometryCollection = "GeometryCollection"
+	geoJSONFeature            = "Feature"
+	geoJSONFeatureCollection  = "FeatureCollection"
+)
+
+type (
+	// geoJSONGeometry is a geometry being encoded.
+	geoJSONGeometry struct {
+		Type        string `json:"type"`
+		Coordinates any    `json:"coordinates"`
+	}
+
+	// geoJSONFeatureObject is a Feature being encoded.
+	geoJSONFeatureObject struct {
+		Type       string           `json:"type"`
+		Geometry   *geoJSONGeometry `json:"geometry"`
+		Properties map[string]any   `json:"properties"`
+	}
+
+	// geoJSONInput is a GeoJSON object being decoded. Coordinates are kept raw
+	// until the type is known.
+	geoJSONInput struct {
+		Type        string          `json:"type"`
+		Coordinates json.RawMessage `json:"coordinates"`
+		Geometries  []*geoJSONInput `json:"geometries"`
+		Geometry    *geoJSONInput   `json:"geometry"`
+		Properties  map[string]any  `json:"properties"`
+		Features    []*geoJSONInput `json:"features"`
+	}
+)
+
+// GeoJSON returns the point as a GeoJSON Point geometry.
+func (g LatLng) GeoJSON() ([]byte, error) {
+	return json.Marshal(geoJSONGeometry{Type: geoJSONPoint, Coordinates: geoJSONPosition(g)})
+}
+
+// GeoJSON returns the polygon as a GeoJSON Polygon geometry. Coordinates are
+// in [longitude, latitude] order, rings are closed, and the rings are wound
+// following the right-hand rule as in GeoPolygon.Normalize.
+func (p GeoPolygon) GeoJSON() ([]byte, error) {
+	return json.Marshal(geoJSONGeometry{Type: geoJSONPolygon, Coordinates: geoJSONRings(p)})
+}
+
+// GeoJSON returns the boundary as a GeoJSON Polygon geometry with a single
+// closed ring.
+func (b CellBoundary) GeoJSON() ([]byte, error) {
+	return GeoPolygon{GeoLoop: GeoLoop(b)}.GeoJSON()
+}
+
+// GeoJSON returns the cell as a GeoJSON Feature whose geometry is the cell
+// boundary and whose GeoJSONIndexProperty property is the cell index.
+func (c Cell) GeoJSON() ([]byte, error) {
+	f, err := geoJSONCellFeature(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(f)
+}
+
+// MultiPolygonToGeoJSON returns the polygons, such as the output of
+// CellsToMultiPolygon, as a GeoJSON MultiPolygon geometry.
+func MultiPolygonToGeoJSON(polygons []GeoPolygon) ([]byte, error) {
+	coords := make([][][][2]float64, len(polygons))
+	for i, p := range polygons {
+		coords[i] = geoJSONRings(p)
+	}
+
+	return json.Marshal(geoJSONGeometry{Type: geoJSONMultiPolygon, Coordinates: coords})
+}
+
+// CellsToGeoJSON returns the cells as a GeoJSON FeatureCollection with one
+// Feature per cell, as produced by Cell.GeoJSON.
+func CellsToGeoJSON(cells []Cell) ([]byte, error) {
+	features := make([]geoJSONFeatureObject, len(cells))
+	for i, c := range cells {
+		f, err := geoJSONCellFeature(c)
+		if err != nil {
+			return nil, err
+		}
+		features[i] = f
+	}
+
+	return json.Marshal(struct {
+		Type     string                 `json:"type"`
+		Features []geoJSONFeatureObject `json:"features"`
+	}{Type: geoJSONFeatureCollection, Features: features})
+}
+
+// LatLngFromGeoJSON decodes a GeoJSON Point geometry, or a Feature holding
+// one.
+func LatLngFromGeoJSON(data []byte) (LatLng, error) {
+	obj, err := decodeGeoJSON(data)
+	if err != nil {
+		return LatLng{}, err
+	}
+
+	if obj.Type == geoJSONFeature {
+		if obj.Geometry == nil {
+			return LatLng{}, fmt.Errorf("%w: Feature has no geometry", ErrInvalidGeoJSON)
+		}
+		obj = obj.Geometry
+	}
+
+	if obj.Type != geoJSONPoint {
+		return LatLng{}, fmt.Errorf("%w: expected Point, got %q", ErrInvalidGeoJSON, obj.Type)
+	}
+
+	var pos []float64
+	if err := json.Unmarshal(obj.Coordinates, &pos); err != nil {
+		return LatLng{}, fmt.Errorf("%w: %w", ErrInvalidGeoJSON, err)
+	}
+
+	return latLngFromGeoJSON(pos)
+}
+
+// GeoPolygonsFromGeoJSON decodes the polygons of a GeoJSON Polygon or
+// MultiPolygon geometry, or of a GeometryCollection, Feature or
+// FeatureCollection holding only those. Rings are converted to loops as
+// described on GeoPolygon.
+func GeoPolygonsFromGeoJSON(data []byte) ([]GeoPolygon, error) {
+	obj, err := decodeGeoJSON(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return obj.polygons()
+}
+
+// CellsFromGeoJSON decodes the cells of a GeoJSON Feature or
+// FeatureCollection, such as one produced by CellsToGeoJSON, from the
+// GeoJSONIndexProperty property of each Feature. Geometries are ignored. An
+// invalid index is reported with the *ParseError from ParseCell.
+func CellsFromGeoJSON(data []byte) ([]Cell, error) {
+	obj, err := decodeGeoJSON(data)
+	if err != nil {
+		return nil, err
+	}
+
+	features := []*geoJSONInput{obj}
+	switch obj.Type {
+	case geoJSONFeature:
+	case geoJSONFeatureCollection:
+		features = obj.Features
+	default:
+		return nil, fmt.Errorf("%w: expected Feature or FeatureCollection, got %q", ErrInvalidGeoJSON, obj.Type)
+	}
+
+	cells := make([]Cell, 0, len(features))
+	for _, f := range features {
+		if f == nil || f.Type != geoJSONFeature {
+			return nil, fmt.Errorf("%w: FeatureCollection holds a non-Feature", ErrInvalidGeoJSON)
+		}
+
+		s, ok := f.Properties[GeoJSONIndexProperty].(string)
+		if !ok {
+			return nil, fmt.Errorf("%w: Feature has no %q string property", ErrInvalidGeoJSON, GeoJSONIndexProperty)
+		}
+
+		c, err := ParseCell(s)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q property: %w", ErrInvalidGeoJSON, GeoJSONIndexProperty, err)
+		}
+		cells = append(cells, c)
+	}
+
+	return cells, nil
+}
+
+func decodeGeoJSON(data []byte) (*geoJSONInput, error) {
+	var obj geoJSONInput
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidGeoJSON, err)
+	}
+
+	return &obj, nil
+}
+
+func (obj *geoJSONInput) polygons() ([]GeoPolygon, error) {
+	if obj == nil {
+		return nil, fmt.Errorf("%w: missing geometry", ErrInvalidGeoJSON)
+	}
+
+	switch obj.Type {
+	case geoJSONPolygon:
+		var rings [][][]float64
+		if err := json.Unmarshal(obj.Coordinates, &rings); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidGeoJSON, err)
+		}
+
+		p, err := geoPolygonFromGeoJSON(rings)
+		if err != nil {
+			return nil, err
+		}
+
+		return []GeoPolygon{p}, nil
+
+	case geoJSONMultiPolygon:
+		var polygons [][][][]float64
+		if err := json.Unmarshal(obj.Coordinates, &polygons); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidGeoJSON, err)
+		}
+
+		out := make([]GeoPolygon, len(polygons))
+		for i, rings := range polygons {
+			p, err := geoPolygonFromGeoJSON(rings)
+			if err != nil {
+				return nil, err
+			}
+			out[i] = p
+		}
+
+		return out, nil
+
+	case geoJSONFeature:
+		return obj.Geometry.polygons()
+
+	case geoJSONGeometryCollection, geoJSONFeatureCollection:
+		members := obj.Geometries
+		if obj.Type == geoJSONFeatureCollection {
+			members = obj.Features
+		}
+
+		var out []GeoPolygon
+		for _, m := range members {
+			polygons, err := m.polygons()
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, polygons...)
+		}
+
+		return out, nil
+
+	default:
+		return nil, fmt.Errorf("%w: expected Polygon or MultiPolygon, got %q", ErrInvalidGeoJSON, obj.Type)
+	}
+}
+
+func geoPolygonFromGeoJSON(rings [][][]float64) (GeoPolygon, error) {
+	if len(rings) == 0 {
+		return GeoPolygon{}, fmt.Errorf("%w: Polygon has no rings", ErrInvalidGeoJSON)
+	}
+
+	loops := make([]GeoLoop, len(rings))
+	for i, ring := range rings {
+		// A closed ring of a polygon has at least 4 positions, the last equal
+		// to the first.
+		if len(ring) < 4 { //nolint:mnd // see above
+			return GeoPolygon{}, fmt.Errorf("%w: ring has %d positions, need at least 4", ErrInvalidGeoJSON, len(ring))
+		}
+
+		loop := make(GeoLoop, len(ring))
+		for j, pos := range ring {
+			g, err := latLngFromGeoJSON(pos)
+			if err != nil {
+				return GeoPolygon{}, err
+			}
+			loop[j] = g
+		}
+
+		if loop[0] != loop[len(loop)-1] {
+			return GeoPolygon{}, fmt.Errorf("%w: ring is not closed", ErrInvalidGeoJSON)
+		}
+		loops[i] = loop[:len(loop)-1]
+	}
+
+	p := GeoPolygon{GeoLoop: loops[0]}
+	if len(loops) > 1 {
+		p.Holes = loops[1:]
+	}
+
+	return p, nil
+}
+
+// latLngFromGeoJSON converts a position, ignoring any altitude.
+func latLngFromGeoJSON(pos []float64) (LatLng, error) {
+	if len(pos) < 2 { //nolint:mnd // longitude and latitude
+		return LatLng{}, fmt.Errorf("%w: position has %d elements, need at least 2", ErrInvalidGeoJSON, len(pos))
+	}
+
+	return LatLng{Lat: pos[1], Lng: pos[0]}, nil
+}
+
+func geoJSONPosition(g LatLng) [2]float64 {
+	return [2]float64{g.Lng, g.Lat}
+}
+
+// geoJSONRings returns the closed rings of the normalized polygon.
+func geoJSONRings(p GeoPolygon) [][][2]float64 {
+	if len(p.GeoLoop) == 0 {
+		return [][][2]float64{}
+	}
+	p = p.Normalize()
+
+	rings := make([][][2]float64, 0, 1+len(p.Holes))
+	for _, loop := range append([]GeoLoop{p.GeoLoop}, p.Holes...) {
+		ring := make([][2]float64, 0, len(loop)+1)
+		for _, g := range loop {
+			ring = append(ring, geoJSONPosition(g))
+		}
+		if len(loop) > 0 && loop[0] != loop[len(loop)-1] {
+			ring = append(ring, geoJSONPosition(loop[0]))
+		}
+		rings = append(rings, ring)
+	}
+
+	return rings
+}
+
+func geoJSONCellFeature(c Cell) (geoJSONFeatureObject, error) {
+	boundary, err := c.Boundary()
+	if err != nil {
+		return geoJSONFeatureObject{}, err
+	}
+
+	return geoJSONFeatureObject{
+		Type:       geoJSONFeature,
+		Geometry:   &geoJSONGeometry{Type: geoJSONPolygon, Coordinates: geoJSONRings(GeoPolygon{GeoLoop: GeoLoop(boundary)})},
+		Properties: map[string]any{GeoJSONIndexProperty: c.String()},
+	}, nil
+}
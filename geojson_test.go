@@ -0,0 +1,188 @@
+/*
+ * Copyright 2026 Uber Technologies, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *         http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package h3
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestLatLngGeoJSON(t *testing.T) {
+	t.Parallel()
+
+	data, err := NewLatLng(37.5, -122.25).GeoJSON()
+	assertNoErr(t, err)
+	assertEqual(t, `{"type":"Point","coordinates":[-122.25,37.5]}`, string(data))
+
+	g, err := LatLngFromGeoJSON(data)
+	assertNoErr(t, err)
+	assertEqual(t, NewLatLng(37.5, -122.25), g)
+
+	g, err = LatLngFromGeoJSON([]byte(`{"type":"Feature","geometry":{"type":"Point","coordinates":[1,2,3]},"properties":null}`))
+	assertNoErr(t, err)
+	assertEqual(t, NewLatLng(2, 1), g)
+
+	for _, bad := range []string{
+		`{"type":"Point","coordinates":[1]}`,
+		`{"type":"Polygon","coordinates":[]}`,
+		`{"type":"Feature","geometry":null,"properties":null}`,
+		`{"type":"Point","coordinates":"x"}`,
+		`not json`,
+	} {
+		_, err := LatLngFromGeoJSON([]byte(bad))
+		assertErrIs(t, err, ErrInvalidGeoJSON)
+	}
+}
+
+func TestGeoPolygonGeoJSON(t *testing.T) {
+	t.Parallel()
+
+	data, err := validGeoPolygonHoles.GeoJSON()
+	assertNoErr(t, err)
+
+	polygons, err := GeoPolygonsFromGeoJSON(data)
+	assertNoErr(t, err)
+	assertEqual(t, 1, len(polygons))
+
+	expected := validGeoPolygonHoles.Normalize()
+	assertEqualLatLngs(t, expected.GeoLoop, polygons[0].GeoLoop)
+	assertEqual(t, len(expected.Holes), len(polygons[0].Holes))
+	for i := range expected.Holes {
+		assertEqualLatLngs(t, expected.Holes[i], polygons[0].Holes[i])
+	}
+
+	cells, err := PolygonToCells(polygons[0], 9)
+	assertNoErr(t, err)
+	expectedCells, err := PolygonToCells(validGeoPolygonHoles, 9)
+	assertNoErr(t, err)
+	assertEqualCells(t, expectedCells, cells)
+}
+
+func TestCellBoundaryGeoJSON(t *testing.T) {
+	t.Parallel()
+
+	boundary, err := validCell.Boundary()
+	assertNoErr(t, err)
+
+	data, err := boundary.GeoJSON()
+	assertNoErr(t, err)
+
+	var geometry struct {
+		Type        string
+		Coordinates [][][2]float64
+	}
+	assertNoErr(t, json.Unmarshal(data, &geometry))
+	assertEqual(t, "Polygon", geometry.Type)
+	assertEqual(t, 1, len(geometry.Coordinates))
+
+	ring := geometry.Coordinates[0]
+	assertEqual(t, len(boundary)+1, len(ring))
+	assertEqual(t, ring[0], ring[len(ring)-1])
+	for i, v := range boundary {
+		assertEqual(t, [2]float64{v.Lng, v.Lat}, ring[i])
+	}
+}
+
+func TestMultiPolygonToGeoJSON(t *testing.T) {
+	t.Parallel()
+
+	cells, err := validCell.GridDisk(1)
+	assertNoErr(t, err)
+	multi, err := CellsToMultiPolygon(append(cells, pentagonCell))
+	assertNoErr(t, err)
+
+	data, err := MultiPolygonToGeoJSON(multi)
+	assertNoErr(t, err)
+
+	polygons, err := GeoPolygonsFromGeoJSON(data)
+	assertNoErr(t, err)
+	assertEqual(t, len(multi), len(polygons))
+	for i := range multi {
+		assertEqualLatLngs(t, multi[i].Normalize().GeoLoop, polygons[i].GeoLoop)
+	}
+
+	collection := `{"type":"FeatureCollection","features":[` +
+		`{"type":"Feature","geometry":` + string(data) + `,"properties":null},` +
+		`{"type":"Feature","geometry":{"type":"GeometryCollection","geometries":[` + string(data) + `]},"properties":{}}]}`
+	polygons, err = GeoPolygonsFromGeoJSON([]byte(collection))
+	assertNoErr(t, err)
+	assertEqual(t, 2*len(multi), len(polygons))
+
+	for _, bad := range []string{
+		`{"type":"Point","coordinates":[1,2]}`,
+		`{"type":"Polygon","coordinates":[]}`,
+		`{"type":"Polygon","coordinates":[[[0,0],[1,0],[0,0]]]}`,
+		`{"type":"Polygon","coordinates":[[[0,0],[1,0],[1,1],[0,1]]]}`,
+		`{"type":"MultiPolygon","coordinates":[[[[0,0],[1,0],[1,1],[0,1]]]]}`,
+		`{"type":"Feature","geometry":null,"properties":null}`,
+		`{"type":"FeatureCollection","features":[{"type":"Feature","geometry":{"type":"Point","coordinates":[1,2]},"properties":null}]}`,
+	} {
+		_, err := GeoPolygonsFromGeoJSON([]byte(bad))
+		assertErrIs(t, err, ErrInvalidGeoJSON)
+	}
+}
+
+func TestCellsGeoJSON(t *testing.T) {
+	t.Parallel()
+
+	data, err := validCell.GeoJSON()
+	assertNoErr(t, err)
+
+	var feature struct {
+		Type       string
+		Properties map[string]string
+	}
+	assertNoErr(t, json.Unmarshal(data, &feature))
+	assertEqual(t, "Feature", feature.Type)
+	assertEqual(t, validCell.String(), feature.Properties[GeoJSONIndexProperty])
+
+	cells, err := CellsFromGeoJSON(data)
+	assertNoErr(t, err)
+	assertEqualCells(t, []Cell{validCell}, cells)
+
+	disk, err := validCell.GridDisk(1)
+	assertNoErr(t, err)
+	data, err = CellsToGeoJSON(disk)
+	assertNoErr(t, err)
+
+	cells, err = CellsFromGeoJSON(data)
+	assertNoErr(t, err)
+	assertEqualCells(t, disk, cells)
+
+	_, err = CellsToGeoJSON([]Cell{validCell, Cell(-1)})
+	assertErrIs(t, err, ErrCellInvalid)
+
+	for _, bad := range []string{
+		`{"type":"Feature","geometry":null,"properties":{"index":"abc"}}`,
+		`{"type":"Feature","geometry":null,"properties":{"index":1}}`,
+		`{"type":"Feature","geometry":null,"properties":null}`,
+		`{"type":"FeatureCollection","features":[{"type":"Point","coordinates":[1,2]}]}`,
+		`{"type":"Point","coordinates":[1,2]}`,
+	} {
+		_, err := CellsFromGeoJSON([]byte(bad))
+		assertErrIs(t, err, ErrInvalidGeoJSON)
+	}
+
+	_, err = CellsFromGeoJSON([]byte(`{"type":"Feature","geometry":null,"properties":{"index":"850dab63ffffff"}}`))
+	assertErrIs(t, err, ErrInvalidGeoJSON)
+	assertErrIs(t, err, ErrCellInvalid)
+
+	var pe *ParseError
+	assertTrue(t, errors.As(err, &pe))
+	assertEqual(t, 14, pe.Offset)
+}
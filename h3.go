@@ -177,7 +177,18 @@ type (
 	//
 	// [H3 index]: https://h3geo.org/docs/core-library/h3Indexing
 	Index interface {
-		Cell | DirectedEdge | Vertex
+		Cell | DirectedEdge | UndirectedEdge | Vertex
+	}
+
+	// AnyIndex is the set of methods shared by every Index type, for values
+	// whose mode is only known at run time, such as those returned by
+	// ParseIndex. Use a type switch to recover the concrete type.
+	AnyIndex interface {
+		Resolution() int
+		String() string
+		IsValid() bool
+		IndexDigit(resolution int) (int, error)
+		IndexParts() IndexParts
 	}
 
 	// CoordIJ IJ hexagon coordinates
@@ -200,6 +211,12 @@ type (
 	}
 
 	// GeoPolygon is a GeoLoop with 0 or more GeoLoop holes.
+	//
+	// Its loops are open: unlike a ring in GeoJSON, WKT or WKB, a loop does not
+	// repeat its first point at the end. Decoders such as
+	// GeoPolygonsFromGeoJSON drop the closing point of each ring and use the
+	// first ring of each polygon as the outer loop, so their results can be
+	// passed to PolygonToCells.
 	GeoPolygon struct {
 		GeoLoop GeoLoop
 		Holes   []GeoLoop
@@ -231,7 +248,7 @@ func LatLngToCell(latLng LatLng, resolution int) (Cell, error) {
 	cLatLng := latLng.toC()
 	errC := C.latLngToCell(&cLatLng, C.int(resolution), &i)
 
-	return Cell(i), toErr(errC)
+	return Cell(i), toOpErr(errC, "LatLngToCell", latLng, resolution)
 }
 
 // Cell returns the Cell at resolution for a geographic coordinate.
@@ -245,7 +262,7 @@ func CellToLatLng(c Cell) (LatLng, error) {
 
 	errC := C.cellToLatLng(C.H3Index(c), &g)
 
-	return latLngFromC(g), toErr(errC)
+	return latLngFromC(g), toOpErr(errC, "CellToLatLng", c)
 }
 
 // LatLng returns the Cell at resolution for a geographic coordinate.
@@ -259,7 +276,7 @@ func CellToBoundary(c Cell) (CellBoundary, error) {
 
 	errC := C.cellToBoundary(C.H3Index(c), &cb)
 
-	return cellBndryFromC(&cb), toErr(errC)
+	return cellBndryFromC(&cb), toOpErr(errC, "CellToBoundary", c)
 }
 
 // Boundary returns a CellBoundary of the Cell.
@@ -278,7 +295,7 @@ func GridDisk(origin Cell, k int) ([]Cell, error) {
 	out := make([]C.H3Index, maxGridDiskSize(k))
 	errC := C.gridDisk(C.H3Index(origin), C.int(k), &out[0])
 	// QUESTION: should we prune zeroes from the output?
-	return cellsFromC(out, true, false), toErr(errC)
+	return cellsFromC(out, true, false), toOpErr(errC, "GridDisk", origin, k)
 }
 
 // GridDisk produces cells within grid distance k of the origin cell.
@@ -308,7 +325,7 @@ func GridDisksUnsafe(origins []Cell, k int) ([][]Cell, error) {
 	flat := make([]C.H3Index, len(origins)*gridDiskSize)
 	cin := cellsToC(origins)
 	errC := C.gridDisksUnsafe(&cin[0], C.int(len(origins)), C.int(k), &flat[0])
-	if err := toErr(errC); err != nil {
+	if err := toOpErr(errC, "GridDisksUnsafe", k); err != nil {
 		return nil, err
 	}
 	out := make([][]Cell, len(origins))
@@ -334,7 +351,8 @@ func GridDiskDistances(origin Cell, k int) ([][]Cell, error) {
 	outHexes := make([]C.H3Index, rsz)
 	outDists := make([]C.int, rsz)
 
-	if err := toErr(C.gridDiskDistances(C.H3Index(origin), C.int(k), &outHexes[0], &outDists[0])); err != nil {
+	errC := C.gridDiskDistances(C.H3Index(origin), C.int(k), &outHexes[0], &outDists[0])
+	if err := toOpErr(errC, "GridDiskDistances", origin, k); err != nil {
 		return nil, err
 	}
 
@@ -380,7 +398,8 @@ func GridDiskDistancesUnsafe(origin Cell, k int) ([][]Cell, error) {
 	outHexes := make([]C.H3Index, rsz)
 	outDists := make([]C.int, rsz)
 
-	if err := toErr(C.gridDiskDistancesUnsafe(C.H3Index(origin), C.int(k), &outHexes[0], &outDists[0])); err != nil {
+	errC := C.gridDiskDistancesUnsafe(C.H3Index(origin), C.int(k), &outHexes[0], &outDists[0])
+	if err := toOpErr(errC, "GridDiskDistancesUnsafe", origin, k); err != nil {
 		return nil, err
 	}
 
@@ -424,7 +443,8 @@ func GridDiskDistancesSafe(origin Cell, k int) ([][]Cell, error) {
 	outHexes := make([]C.H3Index, rsz)
 	outDists := make([]C.int, rsz)
 
-	if err := toErr(C.gridDiskDistancesSafe(C.H3Index(origin), C.int(k), &outHexes[0], &outDists[0])); err != nil {
+	errC := C.gridDiskDistancesSafe(C.H3Index(origin), C.int(k), &outHexes[0], &outDists[0])
+	if err := toOpErr(errC, "GridDiskDistancesSafe", origin, k); err != nil {
 		return nil, err
 	}
 
@@ -460,11 +480,11 @@ func (c Cell) GridDiskDistancesSafe(k int) ([][]Cell, error) {
 // Elements of the output array may be left zero, as can happen when crossing a pentagon.
 func GridRing(origin Cell, k int) ([]Cell, error) {
 	if k < 0 {
-		return nil, ErrDomain
+		return nil, toOpErr(C.E_DOMAIN, "GridRing", origin, k)
 	}
 	out := make([]C.H3Index, ringSize(k))
 	errC := C.gridRing(C.H3Index(origin), C.int(k), &out[0])
-	return cellsFromC(out, true, false), toErr(errC)
+	return cellsFromC(out, true, false), toOpErr(errC, "GridRing", origin, k)
 }
 
 // GridRing produces the "hollow" ring of cells at exactly grid distance k from the origin cell.
@@ -481,11 +501,11 @@ func (c Cell) GridRing(k int) ([]Cell, error) {
 // k-ring 0 returns just the origin hexagon.
 func GridRingUnsafe(origin Cell, k int) ([]Cell, error) {
 	if k < 0 {
-		return nil, ErrDomain
+		return nil, toOpErr(C.E_DOMAIN, "GridRingUnsafe", origin, k)
 	}
 	out := make([]C.H3Index, ringSize(k))
 	errC := C.gridRingUnsafe(C.H3Index(origin), C.int(k), &out[0])
-	return cellsFromC(out, true, false), toErr(errC)
+	return cellsFromC(out, true, false), toOpErr(errC, "GridRingUnsafe", origin, k)
 }
 
 // GridRingUnsafe produces the "hollow" ring of cells at exactly grid distance k from the origin cell.
@@ -511,14 +531,15 @@ func PolygonToCells(polygon GeoPolygon, resolution int) ([]Cell, error) {
 	defer freeCGeoPolygon(&cpoly)
 
 	maxLen := new(C.int64_t)
-	if err := toErr(C.maxPolygonToCellsSize(&cpoly, C.int(resolution), 0, maxLen)); err != nil {
+	errC := C.maxPolygonToCellsSize(&cpoly, C.int(resolution), 0, maxLen)
+	if err := toOpErr(errC, "PolygonToCells", resolution); err != nil {
 		return nil, err
 	}
 
 	out := make([]C.H3Index, *maxLen)
-	errC := C.polygonToCells(&cpoly, C.int(resolution), 0, &out[0])
+	errC = C.polygonToCells(&cpoly, C.int(resolution), 0, &out[0])
 
-	return cellsFromC(out, true, false), toErr(errC)
+	return cellsFromC(out, true, false), toOpErr(errC, "PolygonToCells", resolution)
 }
 
 // PolygonToCellsExperimental takes a given GeoJSON-like data structure fills it with the
@@ -541,14 +562,15 @@ func PolygonToCellsExperimental(polygon GeoPolygon, resolution int, mode Contain
 	defer freeCGeoPolygon(&cpoly)
 
 	maxLen := new(C.int64_t)
-	if err := toErr(C.maxPolygonToCellsSizeExperimental(&cpoly, C.int(resolution), C.uint32_t(mode), maxLen)); err != nil {
+	errC := C.maxPolygonToCellsSizeExperimental(&cpoly, C.int(resolution), C.uint32_t(mode), maxLen)
+	if err := toOpErr(errC, "PolygonToCellsExperimental", resolution, mode); err != nil {
 		return nil, err
 	}
 
 	out := make([]C.H3Index, *maxLen)
-	errC := C.polygonToCellsExperimental(&cpoly, C.int(resolution), C.uint32_t(mode), C.int64_t(maxNumCells), &out[0])
+	errC = C.polygonToCellsExperimental(&cpoly, C.int(resolution), C.uint32_t(mode), C.int64_t(maxNumCells), &out[0])
 
-	return cellsFromC(out, true, false), toErr(errC)
+	return cellsFromC(out, true, false), toOpErr(errC, "PolygonToCellsExperimental", resolution, mode)
 }
 
 // Cells takes a given GeoJSON-like data structure fills it with the
@@ -576,7 +598,8 @@ func CellsToMultiPolygon(cells []Cell) ([]GeoPolygon, error) {
 	}
 	h3Indexes := cellsToC(cells)
 	cLinkedGeoPolygon := new(C.LinkedGeoPolygon)
-	if err := toErr(C.cellsToLinkedMultiPolygon(&h3Indexes[0], C.int(len(h3Indexes)), cLinkedGeoPolygon)); err != nil {
+	errC := C.cellsToLinkedMultiPolygon(&h3Indexes[0], C.int(len(h3Indexes)), cLinkedGeoPolygon)
+	if err := toOpErr(errC, "CellsToMultiPolygon"); err != nil {
 		return nil, err
 	}
 
@@ -664,7 +687,7 @@ func HexagonAreaAvgKm2(resolution int) (float64, error) {
 
 	errC := C.getHexagonAreaAvgKm2(C.int(resolution), &out)
 
-	return float64(out), toErr(errC)
+	return float64(out), toOpErr(errC, "HexagonAreaAvgKm2", resolution)
 }
 
 // HexagonAreaAvgM2 returns the average hexagon area in square meters at the given
@@ -674,7 +697,7 @@ func HexagonAreaAvgM2(resolution int) (float64, error) {
 
 	errC := C.getHexagonAreaAvgM2(C.int(resolution), &out)
 
-	return float64(out), toErr(errC)
+	return float64(out), toOpErr(errC, "HexagonAreaAvgM2", resolution)
 }
 
 // CellAreaRads2 returns the exact area of specific cell in square radians.
@@ -683,7 +706,7 @@ func CellAreaRads2(c Cell) (float64, error) {
 
 	errC := C.cellAreaRads2(C.H3Index(c), &out)
 
-	return float64(out), toErr(errC)
+	return float64(out), toOpErr(errC, "CellAreaRads2", c)
 }
 
 // CellAreaKm2 returns the exact area of specific cell in square kilometers.
@@ -692,7 +715,7 @@ func CellAreaKm2(c Cell) (float64, error) {
 
 	errC := C.cellAreaKm2(C.H3Index(c), &out)
 
-	return float64(out), toErr(errC)
+	return float64(out), toOpErr(errC, "CellAreaKm2", c)
 }
 
 // CellAreaM2 returns the exact area of specific cell in square meters.
@@ -701,7 +724,7 @@ func CellAreaM2(c Cell) (float64, error) {
 
 	errC := C.cellAreaM2(C.H3Index(c), &out)
 
-	return float64(out), toErr(errC)
+	return float64(out), toOpErr(errC, "CellAreaM2", c)
 }
 
 // HexagonEdgeLengthAvgKm returns the average hexagon edge length in kilometers
@@ -711,7 +734,7 @@ func HexagonEdgeLengthAvgKm(resolution int) (float64, error) {
 
 	errC := C.getHexagonEdgeLengthAvgKm(C.int(resolution), &out)
 
-	return float64(out), toErr(errC)
+	return float64(out), toOpErr(errC, "HexagonEdgeLengthAvgKm", resolution)
 }
 
 // HexagonEdgeLengthAvgM returns the average hexagon edge length in meters at
@@ -721,7 +744,7 @@ func HexagonEdgeLengthAvgM(resolution int) (float64, error) {
 
 	errC := C.getHexagonEdgeLengthAvgM(C.int(resolution), &out)
 
-	return float64(out), toErr(errC)
+	return float64(out), toOpErr(errC, "HexagonEdgeLengthAvgM", resolution)
 }
 
 // EdgeLengthRads returns the exact edge length of specific unidirectional edge
@@ -731,7 +754,7 @@ func EdgeLengthRads(e DirectedEdge) (float64, error) {
 
 	errC := C.edgeLengthRads(C.H3Index(e), &out)
 
-	return float64(out), toErr(errC)
+	return float64(out), toOpErr(errC, "EdgeLengthRads", e)
 }
 
 // EdgeLengthKm returns the exact edge length of specific unidirectional
@@ -741,7 +764,7 @@ func EdgeLengthKm(e DirectedEdge) (float64, error) {
 
 	errC := C.edgeLengthKm(C.H3Index(e), &out)
 
-	return float64(out), toErr(errC)
+	return float64(out), toOpErr(errC, "EdgeLengthKm", e)
 }
 
 // EdgeLengthM returns the exact edge length of specific unidirectional
@@ -751,7 +774,7 @@ func EdgeLengthM(e DirectedEdge) (float64, error) {
 
 	errC := C.edgeLengthM(C.H3Index(e), &out)
 
-	return float64(out), toErr(errC)
+	return float64(out), toOpErr(errC, "EdgeLengthM", e)
 }
 
 // NumCells returns the number of cells at the given resolution.
@@ -766,7 +789,7 @@ func Res0Cells() ([]Cell, error) {
 	out := make([]C.H3Index, C.res0CellCount())
 	errC := C.getRes0Cells(&out[0])
 
-	return cellsFromC(out, false, false), toErr(errC)
+	return cellsFromC(out, false, false), toOpErr(errC, "Res0Cells")
 }
 
 // Pentagons returns all the pentagons at resolution.
@@ -774,7 +797,7 @@ func Pentagons(resolution int) ([]Cell, error) {
 	out := make([]C.H3Index, NumPentagons)
 	errC := C.getPentagons(C.int(resolution), &out[0])
 
-	return cellsFromC(out, false, false), toErr(errC)
+	return cellsFromC(out, false, false), toOpErr(errC, "Pentagons", resolution)
 }
 
 // Resolution returns the resolution of the cell.
@@ -805,7 +828,9 @@ func (c Cell) BaseCellNumber() int {
 }
 
 // IndexFromString returns an uint64 from a string. Should call c.IsValid() to check
-// if the Cell is valid before using it.
+// if the Cell is valid before using it. Strings that are not hexadecimal return
+// 0; use ParseCell and the other Parse functions to learn why a string is
+// invalid.
 func IndexFromString(s string) uint64 {
 	if len(s) > 2 && strings.ToLower(s[:2]) == "0x" {
 		s = s[2:]
@@ -853,12 +878,16 @@ func (c Cell) MarshalText() ([]byte, error) {
 	return marshalText(c)
 }
 
-// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// UnmarshalText implements the encoding.TextUnmarshaler interface. It
+// returns a *ParseError describing the problem if text is not a valid
+// cell, as ParseCell does.
 func (c *Cell) UnmarshalText(text []byte) error {
-	*c = CellFromString(string(text))
-	if !c.IsValid() {
-		return errors.New("invalid cell index")
+	parsed, err := ParseCell(string(text))
+	if err != nil {
+		return err
 	}
+	*c = parsed
+
 	return nil
 }
 
@@ -873,7 +902,7 @@ func (c Cell) Parent(resolution int) (Cell, error) {
 
 	errC := C.cellToParent(C.H3Index(c), C.int(resolution), &out)
 
-	return Cell(out), toErr(errC)
+	return Cell(out), toOpErr(errC, "Cell.Parent", c, resolution)
 }
 
 // ImmediateParent returns the immediate parent of the cell.
@@ -885,15 +914,16 @@ func (c Cell) ImmediateParent() (Cell, error) {
 func (c Cell) Children(resolution int) ([]Cell, error) {
 	var outsz C.int64_t
 
-	if err := toErr(C.cellToChildrenSize(C.H3Index(c), C.int(resolution), &outsz)); err != nil {
+	errC := C.cellToChildrenSize(C.H3Index(c), C.int(resolution), &outsz)
+	if err := toOpErr(errC, "Cell.Children", c, resolution); err != nil {
 		return nil, err
 	}
 	out := make([]C.H3Index, outsz)
 
 	// Seems like this function always returns E_SUCCESS.
-	errC := C.cellToChildren(C.H3Index(c), C.int(resolution), &out[0])
+	errC = C.cellToChildren(C.H3Index(c), C.int(resolution), &out[0])
 
-	return cellsFromC(out, false, false), toErr(errC)
+	return cellsFromC(out, false, false), toOpErr(errC, "Cell.Children", c, resolution)
 }
 
 // ImmediateChildren returns the children or grandchildren cells of this Cell.
@@ -907,7 +937,7 @@ func (c Cell) CenterChild(resolution int) (Cell, error) {
 
 	errC := C.cellToCenterChild(C.H3Index(c), C.int(resolution), &out)
 
-	return Cell(out), toErr(errC)
+	return Cell(out), toOpErr(errC, "Cell.CenterChild", c, resolution)
 }
 
 // IsResClassIII returns true if this is a class III index. If false, this is a
@@ -931,7 +961,7 @@ func (c Cell) IcosahedronFaces() ([]int, error) {
 	out := make([]C.int, outsz)
 	errC := C.getIcosahedronFaces(C.H3Index(c), &out[0])
 
-	return intsFromC(out), toErr(errC)
+	return intsFromC(out), toOpErr(errC, "Cell.IcosahedronFaces", c)
 }
 
 // IsNeighbor returns true if this Cell is a neighbor of the other Cell.
@@ -939,7 +969,7 @@ func (c Cell) IsNeighbor(other Cell) (bool, error) {
 	var out C.int
 	errC := C.areNeighborCells(C.H3Index(c), C.H3Index(other), &out)
 
-	return out == 1, toErr(errC)
+	return out == 1, toOpErr(errC, "Cell.IsNeighbor", c, other)
 }
 
 // IndexDigit returns an [indexing digit] of the cell.
@@ -954,7 +984,7 @@ func (c Cell) DirectedEdge(other Cell) (DirectedEdge, error) {
 	var out C.H3Index
 	errC := C.cellsToDirectedEdge(C.H3Index(c), C.H3Index(other), &out)
 
-	return DirectedEdge(out), toErr(errC)
+	return DirectedEdge(out), toOpErr(errC, "Cell.DirectedEdge", c, other)
 }
 
 // DirectedEdges returns 6 directed edges with h as the origin.
@@ -964,7 +994,7 @@ func (c Cell) DirectedEdges() ([]DirectedEdge, error) {
 	// Seems like this function always returns E_SUCCESS.
 	errC := C.originToDirectedEdges(C.H3Index(c), &out[0])
 
-	return edgesFromC(out), toErr(errC)
+	return edgesFromC(out), toOpErr(errC, "Cell.DirectedEdges", c)
 }
 
 // IsValid determines if the directed edge is valid.
@@ -977,7 +1007,7 @@ func (e DirectedEdge) Origin() (Cell, error) {
 	var out C.H3Index
 	errC := C.getDirectedEdgeOrigin(C.H3Index(e), &out)
 
-	return Cell(out), toErr(errC)
+	return Cell(out), toOpErr(errC, "DirectedEdge.Origin", e)
 }
 
 // Destination returns the destination cell of this directed edge.
@@ -985,13 +1015,14 @@ func (e DirectedEdge) Destination() (Cell, error) {
 	var out C.H3Index
 	errC := C.getDirectedEdgeDestination(C.H3Index(e), &out)
 
-	return Cell(out), toErr(errC)
+	return Cell(out), toOpErr(errC, "DirectedEdge.Destination", e)
 }
 
 // Cells returns the origin and destination cells in that order.
 func (e DirectedEdge) Cells() ([]Cell, error) {
 	out := make([]C.H3Index, numEdgeCells)
-	if err := toErr(C.directedEdgeToCells(C.H3Index(e), &out[0])); err != nil {
+	errC := C.directedEdgeToCells(C.H3Index(e), &out[0])
+	if err := toOpErr(errC, "DirectedEdge.Cells", e); err != nil {
 		return nil, err
 	}
 
@@ -1004,7 +1035,8 @@ func (e DirectedEdge) Cells() ([]Cell, error) {
 // 2 coordinates to account for crossing faces.
 func (e DirectedEdge) Boundary() (CellBoundary, error) {
 	var out C.CellBoundary
-	if err := toErr(C.directedEdgeToBoundary(C.H3Index(e), &out)); err != nil {
+	errC := C.directedEdgeToBoundary(C.H3Index(e), &out)
+	if err := toOpErr(errC, "DirectedEdge.Boundary", e); err != nil {
 		return nil, err
 	}
 
@@ -1028,12 +1060,16 @@ func (e DirectedEdge) MarshalText() ([]byte, error) {
 	return marshalText(e)
 }
 
-// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// UnmarshalText implements the encoding.TextUnmarshaler interface. It
+// returns a *ParseError describing the problem if text is not a valid
+// directed edge, as ParseDirectedEdge does.
 func (e *DirectedEdge) UnmarshalText(text []byte) error {
-	*e = DirectedEdgeFromString(string(text))
-	if !e.IsValid() {
-		return errors.New("invalid directed edge index")
+	parsed, err := ParseDirectedEdge(string(text))
+	if err != nil {
+		return err
 	}
+	*e = parsed
+
 	return nil
 }
 
@@ -1047,7 +1083,7 @@ func CompactCells(in []Cell) ([]Cell, error) {
 	cout := make([]C.H3Index, csz)
 	errC := C.compactCells(&cin[0], &cout[0], csz)
 
-	return cellsFromC(cout, false, true), toErr(errC)
+	return cellsFromC(cout, false, true), toOpErr(errC, "CompactCells")
 }
 
 // UncompactCells splits every H3Index in in if its resolution is greater
@@ -1055,17 +1091,18 @@ func CompactCells(in []Cell) ([]Cell, error) {
 func UncompactCells(in []Cell, resolution int) ([]Cell, error) {
 	cin := cellsToC(in)
 	var csz C.int64_t
-	if err := toErr(C.uncompactCellsSize(&cin[0], C.int64_t(len(cin)), C.int(resolution), &csz)); err != nil {
+	errC := C.uncompactCellsSize(&cin[0], C.int64_t(len(cin)), C.int(resolution), &csz)
+	if err := toOpErr(errC, "UncompactCells", resolution); err != nil {
 		return nil, err
 	}
 
 	cout := make([]C.H3Index, csz)
-	errC := C.uncompactCells(
+	errC = C.uncompactCells(
 		&cin[0], C.int64_t(len(in)),
 		&cout[0], csz,
 		C.int(resolution))
 
-	return cellsFromC(cout, false, true), toErr(errC)
+	return cellsFromC(cout, false, true), toOpErr(errC, "UncompactCells", resolution)
 }
 
 // ChildPosToCell returns the child of cell a at a given position within an ordered list of all
@@ -1075,7 +1112,7 @@ func ChildPosToCell(position int, a Cell, resolution int) (Cell, error) {
 
 	errC := C.childPosToCell(C.int64_t(position), C.H3Index(a), C.int(resolution), &out)
 
-	return Cell(out), toErr(errC)
+	return Cell(out), toOpErr(errC, "ChildPosToCell", position, a, resolution)
 }
 
 // ChildPosToCell returns the child cell at a given position within an ordered list of all
@@ -1091,7 +1128,7 @@ func CellToChildPos(a Cell, resolution int) (int, error) {
 
 	errC := C.cellToChildPos(C.H3Index(a), C.int(resolution), &out)
 
-	return int(out), toErr(errC)
+	return int(out), toOpErr(errC, "CellToChildPos", a, resolution)
 }
 
 // ChildPos returns the position of the cell within an ordered list of all children of the cell's parent
@@ -1108,7 +1145,7 @@ func GridDistance(a, b Cell) (int, error) {
 	var out C.int64_t
 	errC := C.gridDistance(C.H3Index(a), C.H3Index(b), &out)
 
-	return int(out), toErr(errC)
+	return int(out), toOpErr(errC, "GridDistance", a, b)
 }
 
 // GridDistance returns grid distance between two cells.
@@ -1125,12 +1162,14 @@ func (c Cell) GridDistance(other Cell) (int, error) {
 // when finding distances for indexes on opposite sides of a pentagon.
 func GridPath(a, b Cell) ([]Cell, error) {
 	var outsz C.int64_t
-	if err := toErr(C.gridPathCellsSize(C.H3Index(a), C.H3Index(b), &outsz)); err != nil {
+	errC := C.gridPathCellsSize(C.H3Index(a), C.H3Index(b), &outsz)
+	if err := toOpErr(errC, "GridPath", a, b); err != nil {
 		return nil, err
 	}
 
 	out := make([]C.H3Index, outsz)
-	if err := toErr(C.gridPathCells(C.H3Index(a), C.H3Index(b), &out[0])); err != nil {
+	errC = C.gridPathCells(C.H3Index(a), C.H3Index(b), &out[0])
+	if err := toOpErr(errC, "GridPath", a, b); err != nil {
 		return nil, err
 	}
 
@@ -1156,7 +1195,7 @@ func CellToLocalIJ(origin, cell Cell) (CoordIJ, error) {
 	var out C.CoordIJ
 	errC := C.cellToLocalIj(C.H3Index(origin), C.H3Index(cell), 0, &out)
 
-	return CoordIJ{int(out.i), int(out.j)}, toErr(errC)
+	return CoordIJ{int(out.i), int(out.j)}, toOpErr(errC, "CellToLocalIJ", origin, cell)
 }
 
 // LocalIJToCell produces a cell for ij coordinates anchored by an origin.
@@ -1168,7 +1207,7 @@ func LocalIJToCell(origin Cell, ij CoordIJ) (Cell, error) {
 	var out C.H3Index
 	errC := C.localIjToCell(C.H3Index(origin), ij.toCPtr(), 0, &out)
 
-	return Cell(out), toErr(errC)
+	return Cell(out), toOpErr(errC, "LocalIJToCell", origin, ij)
 }
 
 // Vertex returns a single vertex for a given cell, or InvalidH3Index if the vertex is invalid.
@@ -1181,7 +1220,7 @@ func CellToVertex(c Cell, vertexNum int) (Vertex, error) {
 	var out C.H3Index
 	errC := C.cellToVertex(C.H3Index(c), C.int(vertexNum), &out)
 
-	return Vertex(out), toErr(errC)
+	return Vertex(out), toOpErr(errC, "CellToVertex", c, vertexNum)
 }
 
 // Vertexes returns all vertexes for the given cell.
@@ -1192,7 +1231,8 @@ func (c Cell) Vertexes() ([]Vertex, error) {
 // CellToVertexes returns all vertexes for the given cell.
 func CellToVertexes(c Cell) ([]Vertex, error) {
 	out := make([]C.H3Index, numCellVertexes)
-	if err := toErr(C.cellToVertexes(C.H3Index(c), &out[0])); err != nil {
+	errC := C.cellToVertexes(C.H3Index(c), &out[0])
+	if err := toOpErr(errC, "CellToVertexes", c); err != nil {
 		return nil, err
 	}
 	return vertexesFromC(out), nil
@@ -1207,7 +1247,7 @@ func (v Vertex) LatLng() (LatLng, error) {
 func VertexToLatLng(vertex Vertex) (LatLng, error) {
 	var out C.LatLng
 	errC := C.vertexToLatLng(C.H3Index(vertex), &out)
-	return latLngFromC(out), toErr(errC)
+	return latLngFromC(out), toOpErr(errC, "VertexToLatLng", vertex)
 }
 
 // IsValid returns whether the cell is a valid vertex.
@@ -1230,19 +1270,23 @@ func (v Vertex) MarshalText() ([]byte, error) {
 	return marshalText(v)
 }
 
-// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// UnmarshalText implements the encoding.TextUnmarshaler interface. It
+// returns a *ParseError describing the problem if text is not a valid
+// vertex, as ParseVertex does.
 func (v *Vertex) UnmarshalText(text []byte) error {
-	*v = VertexFromString(string(text))
-	if !v.IsValid() {
-		return errors.New("invalid cell index")
+	parsed, err := ParseVertex(string(text))
+	if err != nil {
+		return err
 	}
+	*v = parsed
+
 	return nil
 }
 
 // IsValidIndex returns whether the given index is valid.
-// This is a generic function that accepts any H3 index type (Cell, DirectedEdge, or Vertex).
+// This is a generic function that accepts any H3 index type (Cell, DirectedEdge, UndirectedEdge, or Vertex).
 func IsValidIndex[T Index](index T) bool {
-	return C.isValidIndex(C.H3Index(index)) == 1
+	return isValidIndex(uint64(index))
 }
 
 // IndexDigit returns an [indexing digit] of the vertex.
@@ -1252,6 +1296,10 @@ func (v Vertex) IndexDigit(resolution int) (int, error) {
 	return indexDigit(v, resolution)
 }
 
+func isValidIndex(h uint64) bool {
+	return C.isValidIndex(C.H3Index(h)) == 1 || isValidUndirectedEdge(h)
+}
+
 func maxGridDiskSize(k int) int {
 	return 3*k*(k+1) + 1
 }
@@ -1459,7 +1507,7 @@ func toErr(errC C.uint32_t) error {
 func indexDigit[I Index](index I, resolution int) (int, error) {
 	var out C.int
 	errC := C.getIndexDigit(C.H3Index(index), C.int(resolution), &out)
-	return int(out), toErr(errC)
+	return int(out), toOpErr(errC, "IndexDigit", index, resolution)
 }
 
 func resolution[I Index](index I) int {
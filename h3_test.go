@@ -896,7 +896,7 @@ func TestPolygonToCellsExperimental(t *testing.T) {
 			ContainmentOverlappingBbox,
 		} {
 			_, err := PolygonToCellsExperimental(validGeoPolygonHoles, 6, flag, 3)
-			if err != ErrMemoryBounds {
+			if !errors.Is(err, ErrMemoryBounds) {
 				t.Error(t)
 			}
 		}
@@ -0,0 +1,179 @@
+/*
+ * Copyright 2026 Uber Technologies, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *         http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package h3
+
+/*
+#include <h3_h3api.h>
+#include <h3_constants.h>
+#include <h3_h3Index.h>
+*/
+import "C"
+
+// Index modes, stored in bits 1-4 of every index.
+const (
+	CellMode           IndexMode = C.H3_CELL_MODE
+	DirectedEdgeMode   IndexMode = C.H3_DIRECTEDEDGE_MODE
+	UndirectedEdgeMode IndexMode = C.H3_EDGE_MODE
+	VertexMode         IndexMode = C.H3_VERTEX_MODE
+)
+
+const (
+	highBitOffset  = C.H3_MAX_OFFSET
+	modeOffset     = C.H3_MODE_OFFSET
+	reservedOffset = C.H3_RESERVED_OFFSET
+	resOffset      = C.H3_RES_OFFSET
+	baseCellOffset = C.H3_BC_OFFSET
+	digitOffset    = C.H3_PER_DIGIT_OFFSET
+
+	highBitMask  = 1
+	modeMask     = 15
+	reservedMask = 7
+	resMask      = 15
+	baseCellMask = 127
+	digitMask    = 7
+
+	// invalidDigit is the digit value used for resolutions finer than the
+	// resolution of an index.
+	invalidDigit = C.INVALID_DIGIT
+)
+
+type (
+	// IndexMode identifies the concept an index refers to.
+	IndexMode int
+
+	// IndexParts is an index decomposed into its [bit layout] fields. The
+	// zero value is not a valid index.
+	//
+	// [bit layout]: https://h3geo.org/docs/library/index/cell
+	IndexParts struct {
+		// HighBit is the reserved top bit, which is always 0 in valid indexes.
+		HighBit int
+		// Mode is the index mode.
+		Mode IndexMode
+		// Reserved holds the mode-dependent bits: 0 for cells, the edge
+		// direction (1-6) for directed and undirected edges and the vertex
+		// number (0-5) for vertexes.
+		Reserved int
+		// Resolution is the resolution of the index (0-15).
+		Resolution int
+		// BaseCell is the base cell number (0-121).
+		BaseCell int
+		// Digits are the indexing digits, where Digits[r-1] is the digit for
+		// resolution r. Digits for resolutions finer than Resolution are 7 in
+		// valid indexes.
+		Digits [MaxResolution]int
+	}
+)
+
+// ConstructCell returns the cell at resolution with the given base cell number
+// and indexing digits, where digits[r-1] is the digit for resolution r.
+//
+// digits must hold at least resolution values; any beyond that are ignored.
+// Each digit must be in [0, 6], and digit sequences deleted from pentagons are
+// rejected.
+func ConstructCell(resolution, baseCellNumber int, digits []int) (Cell, error) {
+	if resolution > 0 && len(digits) < resolution {
+		return 0, toOpErr(C.E_DIGIT_DOMAIN, "ConstructCell", resolution, baseCellNumber, digits)
+	}
+
+	cdigits := make([]C.int, max(len(digits), 1))
+	for i, d := range digits {
+		cdigits[i] = C.int(d)
+	}
+
+	var out C.H3Index
+	errC := C.constructCell(C.int(resolution), C.int(baseCellNumber), &cdigits[0], &out)
+
+	return Cell(out), toOpErr(errC, "ConstructCell", resolution, baseCellNumber, digits)
+}
+
+// DecomposeIndex splits any index into its bit layout fields. It does not
+// validate the index, so it can be used to inspect corrupted values.
+func DecomposeIndex[I Index](index I) IndexParts {
+	h := uint64(index)
+
+	p := IndexParts{
+		HighBit:    int(h >> highBitOffset & highBitMask),
+		Mode:       IndexMode(h >> modeOffset & modeMask),
+		Reserved:   int(h >> reservedOffset & reservedMask),
+		Resolution: int(h >> resOffset & resMask),
+		BaseCell:   int(h >> baseCellOffset & baseCellMask),
+	}
+	for r := 1; r <= MaxResolution; r++ {
+		p.Digits[r-1] = int(h >> digitShift(r) & digitMask)
+	}
+
+	return p
+}
+
+// Index assembles the fields into an index and validates it.
+//
+// An error is returned if a field does not fit in its bits, or if the
+// assembled index is not a valid cell, directed edge, undirected edge or
+// vertex. In the latter case the assembled value is returned alongside the
+// error.
+func (p IndexParts) Index() (uint64, error) {
+	switch {
+	case p.HighBit&^highBitMask != 0,
+		int(p.Mode)&^modeMask != 0,
+		p.Reserved&^reservedMask != 0:
+		return 0, toOpErr(C.E_DOMAIN, "IndexParts.Index", p)
+	case p.Resolution < 0 || p.Resolution > MaxResolution:
+		return 0, toOpErr(C.E_RES_DOMAIN, "IndexParts.Index", p)
+	case p.BaseCell&^baseCellMask != 0:
+		return 0, toOpErr(C.E_BASE_CELL_DOMAIN, "IndexParts.Index", p)
+	}
+
+	h := uint64(p.HighBit)<<highBitOffset |
+		uint64(p.Mode)<<modeOffset |
+		uint64(p.Reserved)<<reservedOffset |
+		uint64(p.Resolution)<<resOffset |
+		uint64(p.BaseCell)<<baseCellOffset
+
+	for r, d := range p.Digits {
+		if d&^digitMask != 0 {
+			return 0, toOpErr(C.E_DIGIT_DOMAIN, "IndexParts.Index", p)
+		}
+		h |= uint64(d) << digitShift(r+1)
+	}
+
+	if !isValidIndex(h) {
+		return h, toOpErr(C.E_INDEX_INVALID, "IndexParts.Index", IndexToString(h))
+	}
+
+	return h, nil
+}
+
+// IndexParts splits the cell into its bit layout fields.
+func (c Cell) IndexParts() IndexParts {
+	return DecomposeIndex(c)
+}
+
+// IndexParts splits the directed edge into its bit layout fields.
+func (e DirectedEdge) IndexParts() IndexParts {
+	return DecomposeIndex(e)
+}
+
+// IndexParts splits the vertex into its bit layout fields.
+func (v Vertex) IndexParts() IndexParts {
+	return DecomposeIndex(v)
+}
+
+// digitShift returns the offset of the indexing digit for resolution.
+func digitShift(resolution int) int {
+	return (MaxResolution - resolution) * digitOffset
+}
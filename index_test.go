@@ -0,0 +1,175 @@
+/*
+ * Copyright 2026 Uber Technologies, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *         http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package h3
+
+import "testing"
+
+func TestConstructCell(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		p := validCell.IndexParts()
+		c, err := ConstructCell(p.Resolution, p.BaseCell, p.Digits[:p.Resolution])
+		assertNoErr(t, err)
+		assertEqual(t, validCell, c)
+
+		c, err = ConstructCell(0, 0, nil)
+		assertNoErr(t, err)
+		assertEqual(t, Cell(0x8001fffffffffff), c)
+	})
+
+	t.Run("err/resolution", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ConstructCell(MaxResolution+1, 0, make([]int, MaxResolution+1))
+		assertErrIs(t, err, ErrResolutionDomain)
+	})
+
+	t.Run("err/base_cell", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ConstructCell(1, NumBaseCells, []int{0})
+		assertErrIs(t, err, ErrBaseCellDomain)
+	})
+
+	t.Run("err/digits", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ConstructCell(2, 0, []int{0})
+		assertErrIs(t, err, ErrDigitDomain)
+
+		_, err = ConstructCell(2, 0, []int{0, 7})
+		assertErrIs(t, err, ErrDigitDomain)
+	})
+
+	t.Run("err/deleted_digit", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ConstructCell(2, pentagonCell.BaseCellNumber(), []int{0, 1})
+		assertErrIs(t, err, ErrDeletedDigit)
+	})
+}
+
+func TestDecomposeIndex(t *testing.T) {
+	t.Parallel()
+
+	t.Run("cell", func(t *testing.T) {
+		t.Parallel()
+
+		p := DecomposeIndex(validCell)
+		assertEqual(t, 0, p.HighBit)
+		assertEqual(t, CellMode, p.Mode)
+		assertEqual(t, 0, p.Reserved)
+		assertEqual(t, 5, p.Resolution)
+		assertEqual(t, 6, p.BaseCell)
+		assertEqual(t, [MaxResolution]int{6, 5, 3, 3, 0, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7}, p.Digits)
+
+		for r := 1; r <= MaxResolution; r++ {
+			d, _ := validCell.IndexDigit(r)
+			assertEqual(t, d, p.Digits[r-1])
+		}
+	})
+
+	t.Run("directed edge", func(t *testing.T) {
+		t.Parallel()
+
+		p := validEdge.IndexParts()
+		assertEqual(t, DirectedEdgeMode, p.Mode)
+		assertEqual(t, 2, p.Reserved)
+		assertEqual(t, 5, p.Resolution)
+	})
+
+	t.Run("vertex", func(t *testing.T) {
+		t.Parallel()
+
+		p := validVertex.IndexParts()
+		assertEqual(t, VertexMode, p.Mode)
+		assertEqual(t, 0, p.Reserved)
+		assertEqual(t, validCell.IndexParts().Digits, p.Digits)
+	})
+}
+
+func TestIndexParts_Index(t *testing.T) {
+	t.Parallel()
+
+	t.Run("round trip", func(t *testing.T) {
+		t.Parallel()
+
+		for _, i := range []uint64{uint64(validCell), uint64(pentagonCell), uint64(validEdge), uint64(validVertex)} {
+			h, err := DecomposeIndex(Cell(i)).Index()
+			assertNoErr(t, err)
+			assertEqual(t, i, h)
+		}
+	})
+
+	t.Run("modified digit", func(t *testing.T) {
+		t.Parallel()
+
+		p := validCell.IndexParts()
+		p.Digits[p.Resolution-1] = 6
+
+		h, err := p.Index()
+		assertNoErr(t, err)
+		assertTrue(t, Cell(h).IsValid())
+
+		parent, _ := Cell(h).ImmediateParent()
+		expected, _ := validCell.ImmediateParent()
+		assertEqual(t, expected, parent)
+	})
+
+	t.Run("err/fields", func(t *testing.T) {
+		t.Parallel()
+
+		valid := validCell.IndexParts()
+		testCases := map[string]struct {
+			modify func(p *IndexParts)
+			err    error
+		}{
+			"high bit":   {func(p *IndexParts) { p.HighBit = 2 }, ErrDomain},
+			"mode":       {func(p *IndexParts) { p.Mode = 16 }, ErrDomain},
+			"reserved":   {func(p *IndexParts) { p.Reserved = -1 }, ErrDomain},
+			"resolution": {func(p *IndexParts) { p.Resolution = 16 }, ErrResolutionDomain},
+			"base cell":  {func(p *IndexParts) { p.BaseCell = 128 }, ErrBaseCellDomain},
+			"digit":      {func(p *IndexParts) { p.Digits[0] = 8 }, ErrDigitDomain},
+		}
+
+		for name, tc := range testCases {
+			p := valid
+			tc.modify(&p)
+
+			h, err := p.Index()
+			assertEqual(t, uint64(0), h, name)
+			assertErrIs(t, err, tc.err)
+		}
+	})
+
+	t.Run("err/invalid", func(t *testing.T) {
+		t.Parallel()
+
+		p := validCell.IndexParts()
+		p.Digits[p.Resolution] = 0
+
+		h, err := p.Index()
+		assertErrIs(t, err, ErrIndexInvalid)
+		assertFalse(t, Cell(h).IsValid())
+
+		_, err = IndexParts{}.Index()
+		assertErrIs(t, err, ErrIndexInvalid)
+	})
+}
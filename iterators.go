@@ -0,0 +1,164 @@
+/*
+ * Copyright 2026 Uber Technologies, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *         http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package h3
+
+/*
+#include <stdlib.h>
+#include <h3_h3api.h>
+#include <h3_iterators.h>
+#include <h3_polyfill.h>
+*/
+import "C"
+
+import (
+	"iter"
+	"unsafe"
+)
+
+// ChildrenSeq returns a sequence of the children or grandchildren cells of
+// this Cell at resolution, in the same order as Children.
+//
+// Cells are produced lazily by the H3 child iterator, so memory use is
+// constant regardless of how many children there are. The sequence is empty
+// if the cell is invalid or resolution is not in [c.Resolution(), MaxResolution].
+func (c Cell) ChildrenSeq(resolution int) iter.Seq[Cell] {
+	return func(yield func(Cell) bool) {
+		if !c.IsValid() {
+			return
+		}
+
+		it := C.iterInitParent(C.H3Index(c), C.int(resolution))
+		for ; it.h != C.H3_NULL; C.iterStepChild(&it) {
+			if !yield(Cell(it.h)) {
+				return
+			}
+		}
+	}
+}
+
+// CellsSeq returns a sequence of every cell at resolution, ordered by base
+// cell number and then by child position.
+//
+// Cells are produced lazily by the H3 resolution iterator, so memory use is
+// constant. The sequence is empty if resolution is not in [0, MaxResolution].
+func CellsSeq(resolution int) iter.Seq[Cell] {
+	return func(yield func(Cell) bool) {
+		it := C.iterInitRes(C.int(resolution))
+		for ; it.h != C.H3_NULL; C.iterStepRes(&it) {
+			if !yield(Cell(it.h)) {
+				return
+			}
+		}
+	}
+}
+
+// BaseCellChildrenSeq returns a sequence of every descendant at resolution of
+// the base cell with the given number (0-121).
+//
+// Cells are produced lazily by the H3 child iterator, so memory use is
+// constant. The sequence is empty if baseCellNumber is not in
+// [0, NumBaseCells) or resolution is not in [0, MaxResolution].
+func BaseCellChildrenSeq(baseCellNumber, resolution int) iter.Seq[Cell] {
+	return func(yield func(Cell) bool) {
+		it := C.iterInitBaseCellNum(C.int(baseCellNumber), C.int(resolution))
+		for ; it.h != C.H3_NULL; C.iterStepChild(&it) {
+			if !yield(Cell(it.h)) {
+				return
+			}
+		}
+	}
+}
+
+// PolygonToCellsSeq returns a sequence of the cells at resolution contained by
+// polygon according to mode. It produces the same cells as
+// PolygonToCellsExperimental without allocating a worst-case output buffer.
+//
+// If the fill fails, the sequence yields a single zero Cell with the error and
+// stops. Memory held by the underlying C iterator is released when the
+// sequence is exhausted or the caller stops iterating early.
+func PolygonToCellsSeq(polygon GeoPolygon, resolution int, mode ContainmentMode) iter.Seq2[Cell, error] {
+	return func(yield func(Cell, error) bool) {
+		if len(polygon.GeoLoop) == 0 {
+			return
+		}
+
+		cpoly := mallocCGeoPolygon(polygon)
+		defer freeMallocCGeoPolygon(cpoly)
+
+		it := C.iterInitPolygon(cpoly, C.int(resolution), C.uint32_t(mode))
+		defer C.iterDestroyPolygon(&it)
+
+		for ; it.cell != C.H3_NULL; C.iterStepPolygon(&it) {
+			if !yield(Cell(it.cell), nil) {
+				return
+			}
+		}
+
+		if err := toOpErr(it.error, "PolygonToCellsSeq", resolution, mode); err != nil {
+			yield(0, err)
+		}
+	}
+}
+
+// PolygonToCompactCellsSeq returns a sequence of the compacted cells contained
+// by polygon according to mode, with resolution as the finest resolution.
+// Uncompacting the output to resolution gives the same cells as
+// PolygonToCellsSeq. A coarser cell is produced when the bounding box of all
+// its descendants lies inside the polygon, so the output is not always as
+// compact as the result of CompactCells.
+//
+// If the fill fails, the sequence yields a single zero Cell with the error and
+// stops. Memory held by the underlying C iterator is released when the
+// sequence is exhausted or the caller stops iterating early.
+func PolygonToCompactCellsSeq(polygon GeoPolygon, resolution int, mode ContainmentMode) iter.Seq2[Cell, error] {
+	return func(yield func(Cell, error) bool) {
+		if len(polygon.GeoLoop) == 0 {
+			return
+		}
+
+		cpoly := mallocCGeoPolygon(polygon)
+		defer freeMallocCGeoPolygon(cpoly)
+
+		it := C.iterInitPolygonCompact(cpoly, C.int(resolution), C.uint32_t(mode))
+		defer C.iterDestroyPolygonCompact(&it)
+
+		for ; it.cell != C.H3_NULL; C.iterStepPolygonCompact(&it) {
+			if !yield(Cell(it.cell), nil) {
+				return
+			}
+		}
+
+		if err := toOpErr(it.error, "PolygonToCompactCellsSeq", resolution, mode); err != nil {
+			yield(0, err)
+		}
+	}
+}
+
+// mallocCGeoPolygon allocates a C GeoPolygon in C memory. The C iterators keep
+// a reference to the polygon between calls, so it cannot live in Go memory.
+// The caller must release it with freeMallocCGeoPolygon.
+func mallocCGeoPolygon(gp GeoPolygon) *C.GeoPolygon {
+	cpoly := (*C.GeoPolygon)(C.malloc(C.sizeof_GeoPolygon))
+	*cpoly = allocCGeoPolygon(gp)
+
+	return cpoly
+}
+
+func freeMallocCGeoPolygon(cpoly *C.GeoPolygon) {
+	freeCGeoPolygon(cpoly)
+	C.free(unsafe.Pointer(cpoly))
+}
@@ -0,0 +1,287 @@
+/*
+ * Copyright 2026 Uber Technologies, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *         http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package h3
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestChildrenSeq(t *testing.T) {
+	t.Parallel()
+
+	t.Run("hexagon", func(t *testing.T) {
+		t.Parallel()
+
+		expected, err := validCell.Children(8)
+		assertNoErr(t, err)
+
+		actual := slices.Collect(validCell.ChildrenSeq(8))
+		assertEqual(t, len(expected), len(actual))
+
+		for i := range expected {
+			assertEqual(t, expected[i], actual[i])
+		}
+	})
+
+	t.Run("pentagon", func(t *testing.T) {
+		t.Parallel()
+
+		expected, err := pentagonCell.Children(5)
+		assertNoErr(t, err)
+
+		actual := slices.Collect(pentagonCell.ChildrenSeq(5))
+		assertEqual(t, len(expected), len(actual))
+
+		for i := range expected {
+			assertEqual(t, expected[i], actual[i])
+		}
+	})
+
+	t.Run("same resolution", func(t *testing.T) {
+		t.Parallel()
+
+		actual := slices.Collect(validCell.ChildrenSeq(validCell.Resolution()))
+		assertEqual(t, 1, len(actual))
+		assertEqual(t, validCell, actual[0])
+	})
+
+	t.Run("break", func(t *testing.T) {
+		t.Parallel()
+
+		res0, _ := Res0Cells()
+
+		var n int
+		for range res0[0].ChildrenSeq(MaxResolution) {
+			n++
+			if n == 10 {
+				break
+			}
+		}
+
+		assertEqual(t, 10, n)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		assertEqual(t, 0, len(slices.Collect(validCell.ChildrenSeq(-1))))
+		assertEqual(t, 0, len(slices.Collect(validCell.ChildrenSeq(MaxResolution+1))))
+		assertEqual(t, 0, len(slices.Collect(validCell.ChildrenSeq(validCell.Resolution()-1))))
+		assertEqual(t, 0, len(slices.Collect(Cell(-1).ChildrenSeq(6))))
+	})
+}
+
+func TestCellsSeq(t *testing.T) {
+	t.Parallel()
+
+	t.Run("res 0", func(t *testing.T) {
+		t.Parallel()
+
+		expected, err := Res0Cells()
+		assertNoErr(t, err)
+
+		actual := slices.Collect(CellsSeq(0))
+		assertEqual(t, len(expected), len(actual))
+
+		for i := range expected {
+			assertEqual(t, expected[i], actual[i])
+		}
+	})
+
+	t.Run("res 2", func(t *testing.T) {
+		t.Parallel()
+
+		var n int
+		for c := range CellsSeq(2) {
+			assertTrue(t, c.IsValid())
+			assertEqual(t, 2, c.Resolution())
+			n++
+		}
+
+		assertEqual(t, NumCells(2), n)
+	})
+
+	t.Run("break", func(t *testing.T) {
+		t.Parallel()
+
+		var n int
+		for range CellsSeq(MaxResolution) {
+			n++
+			if n == 10 {
+				break
+			}
+		}
+
+		assertEqual(t, 10, n)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		assertEqual(t, 0, len(slices.Collect(CellsSeq(-1))))
+		assertEqual(t, 0, len(slices.Collect(CellsSeq(MaxResolution+1))))
+	})
+}
+
+func TestBaseCellChildrenSeq(t *testing.T) {
+	t.Parallel()
+
+	t.Run("hexagon", func(t *testing.T) {
+		t.Parallel()
+
+		res0, _ := Res0Cells()
+		expected, _ := res0[validCell.BaseCellNumber()].Children(3)
+
+		actual := slices.Collect(BaseCellChildrenSeq(validCell.BaseCellNumber(), 3))
+		assertEqual(t, len(expected), len(actual))
+
+		for i := range expected {
+			assertEqual(t, expected[i], actual[i])
+		}
+	})
+
+	t.Run("pentagon", func(t *testing.T) {
+		t.Parallel()
+
+		actual := slices.Collect(BaseCellChildrenSeq(pentagonCell.BaseCellNumber(), 2))
+		// a res 0 pentagon has 6 children at res 1, of which 5 are hexagons.
+		assertEqual(t, 6+5*7, len(actual))
+		assertCellIn(t, pentagonCell, actual)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		assertEqual(t, 0, len(slices.Collect(BaseCellChildrenSeq(-1, 2))))
+		assertEqual(t, 0, len(slices.Collect(BaseCellChildrenSeq(NumBaseCells, 2))))
+		assertEqual(t, 0, len(slices.Collect(BaseCellChildrenSeq(0, -1))))
+	})
+}
+
+func TestPolygonToCellsSeq(t *testing.T) {
+	t.Parallel()
+
+	for _, mode := range []ContainmentMode{
+		ContainmentCenter,
+		ContainmentFull,
+		ContainmentOverlapping,
+		ContainmentOverlappingBbox,
+	} {
+		expected, err := PolygonToCellsExperimental(validGeoPolygonHoles, 7, mode)
+		assertNoErr(t, err)
+
+		var actual []Cell
+		for c, err := range PolygonToCellsSeq(validGeoPolygonHoles, 7, mode) {
+			assertNoErr(t, err)
+			actual = append(actual, c)
+		}
+
+		assertEqualCells(t, expected, actual)
+	}
+
+	t.Run("empty", func(t *testing.T) {
+		t.Parallel()
+
+		for range PolygonToCellsSeq(GeoPolygon{}, 6, ContainmentCenter) {
+			t.Error("expected no cells")
+		}
+	})
+
+	t.Run("break", func(t *testing.T) {
+		t.Parallel()
+
+		var n int
+		for range PolygonToCellsSeq(validGeoPolygonHoles, 9, ContainmentCenter) {
+			n++
+			if n == 3 {
+				break
+			}
+		}
+
+		assertEqual(t, 3, n)
+	})
+
+	t.Run("err/resolution", func(t *testing.T) {
+		t.Parallel()
+
+		var errs []error
+		for c, err := range PolygonToCellsSeq(validGeoPolygonHoles, -1, ContainmentCenter) {
+			assertEqual(t, 0, c)
+			errs = append(errs, err)
+		}
+
+		assertEqual(t, 1, len(errs))
+		assertErrIs(t, errs[0], ErrResolutionDomain)
+	})
+
+	t.Run("err/mode", func(t *testing.T) {
+		t.Parallel()
+
+		for _, err := range PolygonToCellsSeq(validGeoPolygonHoles, 6, ContainmentInvalid) {
+			assertErrIs(t, err, ErrOptionInvalid)
+		}
+	})
+}
+
+func TestPolygonToCompactCellsSeq(t *testing.T) {
+	t.Parallel()
+
+	for _, mode := range []ContainmentMode{
+		ContainmentCenter,
+		ContainmentFull,
+		ContainmentOverlapping,
+		ContainmentOverlappingBbox,
+	} {
+		cells, err := PolygonToCellsExperimental(validGeoPolygonHoles, 8, mode)
+		assertNoErr(t, err)
+
+		var compacted []Cell
+		for c, err := range PolygonToCompactCellsSeq(validGeoPolygonHoles, 8, mode) {
+			assertNoErr(t, err)
+			compacted = append(compacted, c)
+		}
+
+		actual, err := UncompactCells(compacted, 8)
+		assertNoErr(t, err)
+		assertEqualCells(t, cells, actual)
+	}
+
+	t.Run("empty", func(t *testing.T) {
+		t.Parallel()
+
+		for range PolygonToCompactCellsSeq(GeoPolygon{}, 6, ContainmentCenter) {
+			t.Error("expected no cells")
+		}
+	})
+
+	t.Run("break", func(t *testing.T) {
+		t.Parallel()
+
+		for range PolygonToCompactCellsSeq(validGeoPolygonHoles, 9, ContainmentCenter) {
+			break
+		}
+	})
+
+	t.Run("err/resolution", func(t *testing.T) {
+		t.Parallel()
+
+		for _, err := range PolygonToCompactCellsSeq(validGeoPolygonHoles, MaxResolution+1, ContainmentCenter) {
+			assertErrIs(t, err, ErrResolutionDomain)
+		}
+	})
+}
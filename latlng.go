@@ -0,0 +1,125 @@
+/*
+ * Copyright 2026 Uber Technologies, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *         http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package h3
+
+/*
+#include <h3_h3api.h>
+#include <h3_constants.h>
+#include <h3_latLng.h>
+*/
+import "C"
+
+import "math"
+
+const earthRadiusKm = C.EARTH_RADIUS_KM
+
+// AzimuthTo returns the initial bearing of the great circle from this point to
+// other, in degrees clockwise from north in the range [0, 360).
+func (g LatLng) AzimuthTo(other LatLng) float64 {
+	return RadsToDegs * g.AzimuthRadsTo(other)
+}
+
+// AzimuthRadsTo returns the initial bearing of the great circle from this
+// point to other, in radians clockwise from north in the range [0, 2π).
+func (g LatLng) AzimuthRadsTo(other LatLng) float64 {
+	a, b := g.toC(), other.toC()
+	az := float64(C._geoAzimuthRads(&a, &b))
+
+	if az < 0 {
+		az += 2 * math.Pi
+	}
+
+	return az
+}
+
+// DestinationRads returns the point reached by travelling distance radians
+// along the great circle leaving this point at azimuth radians clockwise from
+// north. A negative distance travels in the opposite direction.
+func (g LatLng) DestinationRads(azimuth, distance float64) LatLng {
+	if distance < 0 {
+		azimuth += math.Pi
+		distance = -distance
+	}
+
+	var out C.LatLng
+
+	cg := g.toC()
+	C._geoAzDistanceRads(&cg, C.double(azimuth), C.double(math.Mod(distance, 2*math.Pi)), &out)
+
+	// H3 Core adds the distance to the latitude when travelling due north or
+	// south, which overshoots for paths crossing a pole.
+	lat := math.Remainder(float64(out.lat), 2*math.Pi)
+	if lat > math.Pi/2 || lat < -math.Pi/2 {
+		out.lat = C.double(math.Copysign(math.Pi, lat) - lat)
+		out.lng = C.double(math.Remainder(float64(out.lng)+math.Pi, 2*math.Pi))
+	}
+
+	return latLngFromC(out)
+}
+
+// DestinationKm returns the point reached by travelling distance kilometers
+// along the great circle leaving this point at azimuth degrees clockwise from
+// north. A negative distance travels in the opposite direction.
+func (g LatLng) DestinationKm(azimuth, distance float64) LatLng {
+	return g.DestinationRads(DegsToRads*azimuth, distance/earthRadiusKm)
+}
+
+// DestinationM returns the point reached by travelling distance meters along
+// the great circle leaving this point at azimuth degrees clockwise from north.
+// A negative distance travels in the opposite direction.
+func (g LatLng) DestinationM(azimuth, distance float64) LatLng {
+	return g.DestinationKm(azimuth, distance/1000) //nolint:mnd // meters per kilometer
+}
+
+// GreatCircleInterpolate returns the point at fraction of the way from a to b
+// along the shorter great circle arc between them. A fraction of 0 returns a
+// and 1 returns b; fractions outside [0, 1] extrapolate along the same great
+// circle. The arc between antipodal points is undefined.
+func GreatCircleInterpolate(a, b LatLng, fraction float64) LatLng {
+	switch fraction {
+	case 0:
+		return a
+	case 1:
+		return b
+	}
+
+	return a.DestinationRads(a.AzimuthRadsTo(b), fraction*GreatCircleDistanceRads(a, b))
+}
+
+// unitVector returns the point as a unit vector in Earth-centered Cartesian
+// coordinates.
+func unitVector(g LatLng) [3]float64 {
+	lat, lng := DegsToRads*g.Lat, DegsToRads*g.Lng
+
+	return [3]float64{
+		math.Cos(lat) * math.Cos(lng),
+		math.Cos(lat) * math.Sin(lng),
+		math.Sin(lat),
+	}
+}
+
+func cross(a, b [3]float64) [3]float64 {
+	return [3]float64{
+		a[1]*b[2] - a[2]*b[1],
+		a[2]*b[0] - a[0]*b[2],
+		a[0]*b[1] - a[1]*b[0],
+	}
+}
+
+func dot(a, b [3]float64) float64 {
+	return a[0]*b[0] + a[1]*b[1] + a[2]*b[2]
+}
@@ -0,0 +1,114 @@
+/*
+ * Copyright 2026 Uber Technologies, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *         http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package h3
+
+import (
+	"math"
+	"testing"
+)
+
+func TestLatLng_AzimuthTo(t *testing.T) {
+	t.Parallel()
+
+	origin := NewLatLng(0, 0)
+
+	testCases := []struct {
+		to       LatLng
+		expected float64
+	}{
+		{NewLatLng(10, 0), 0},
+		{NewLatLng(0, 10), 90},
+		{NewLatLng(-10, 0), 180},
+		{NewLatLng(0, -10), 270},
+	}
+
+	for _, tc := range testCases {
+		assertEqualEps(t, tc.expected, origin.AzimuthTo(tc.to), tc.to)
+		assertEqualEps(t, DegsToRads*tc.expected, origin.AzimuthRadsTo(tc.to), tc.to)
+	}
+
+	// Northeast from the equator, but the great circle heads further north
+	// than a rhumb line would.
+	az := origin.AzimuthTo(NewLatLng(45, 45))
+	assertTrue(t, az > 0 && az < 45)
+}
+
+func TestLatLng_Destination(t *testing.T) {
+	t.Parallel()
+
+	t.Run("round trip", func(t *testing.T) {
+		t.Parallel()
+
+		origin := validLatLng1
+		for _, az := range []float64{0, 30, 90, 135, 180, 260, 359} {
+			for _, km := range []float64{0.5, 10, 1000} {
+				dest := origin.DestinationKm(az, km)
+				assertEqualEps(t, km, GreatCircleDistanceKm(origin, dest), az, km)
+				if km > 0 {
+					assertEqualEps(t, math.Mod(az, 360), origin.AzimuthTo(dest), az, km)
+				}
+
+				assertEqualLatLng(t, dest, origin.DestinationM(az, km*1000))
+				assertEqualLatLng(t, dest, origin.DestinationRads(DegsToRads*az, km/earthRadiusKm))
+			}
+		}
+	})
+
+	t.Run("zero distance", func(t *testing.T) {
+		t.Parallel()
+
+		assertEqualLatLng(t, validLatLng1, validLatLng1.DestinationKm(45, 0))
+	})
+
+	t.Run("negative distance", func(t *testing.T) {
+		t.Parallel()
+
+		assertEqualLatLng(t, validLatLng1.DestinationKm(270, 100), validLatLng1.DestinationKm(90, -100))
+	})
+
+	t.Run("over the pole", func(t *testing.T) {
+		t.Parallel()
+
+		dest := NewLatLng(80, 10).DestinationRads(0, DegsToRads*20)
+		assertEqualLatLng(t, NewLatLng(80, -170), dest)
+
+		dest = NewLatLng(-80, 10).DestinationRads(math.Pi, DegsToRads*20)
+		assertEqualLatLng(t, NewLatLng(-80, -170), dest)
+
+		dest = NewLatLng(0, 0).DestinationRads(0, 2*math.Pi)
+		assertEqualLatLng(t, NewLatLng(0, 0), dest)
+	})
+}
+
+func TestGreatCircleInterpolate(t *testing.T) {
+	t.Parallel()
+
+	a, b := validLatLng1, validLatLng2
+	total := GreatCircleDistanceKm(a, b)
+
+	assertEqual(t, a, GreatCircleInterpolate(a, b, 0))
+	assertEqual(t, b, GreatCircleInterpolate(a, b, 1))
+
+	for _, f := range []float64{0.25, 0.5, 0.75} {
+		p := GreatCircleInterpolate(a, b, f)
+		assertEqualEps(t, f*total, GreatCircleDistanceKm(a, p), f)
+		assertEqualEps(t, (1-f)*total, GreatCircleDistanceKm(p, b), f)
+	}
+
+	// Along the equator, the midpoint is halfway in longitude.
+	assertEqualLatLng(t, NewLatLng(0, 20), GreatCircleInterpolate(NewLatLng(0, 10), NewLatLng(0, 30), 0.5))
+}
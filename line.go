@@ -0,0 +1,195 @@
+/*
+ * Copyright 2026 Uber Technologies, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *         http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package h3
+
+/*
+#include <h3_h3api.h>
+#include <h3_bbox.h>
+*/
+import "C"
+
+import "math"
+
+const (
+	// maxLineBisections bounds how often a segment between two sampled cells
+	// is halved when GridPath cannot connect them.
+	maxLineBisections = 16
+
+	// lineAntipodalEpsRads is how close to half a great circle a segment can
+	// be before its path is considered undefined, about 6 mm on the Earth.
+	lineAntipodalEpsRads = 1e-9
+)
+
+// LineToCells returns the cells at resolution that the great-circle segments
+// between consecutive points pass through, such as the cells along a road or a
+// flight path. Cells are ordered along the line and each appears once, at its
+// first visit.
+//
+// Each segment is sampled at roughly one point per cell, and consecutive
+// samples falling in cells that are not neighbors are joined with GridPath.
+// Where GridPath fails, for example across a pentagon, the samples are
+// bisected instead, so unlike GridPath the line may cross pentagons and
+// icosahedron faces. Consecutive cells in the result are neighbors except in
+// the rare case where bisection does not converge.
+//
+// If bufferK is given, every cell within that grid distance of the line is
+// also returned, following the cells of the line in order.
+//
+// The great-circle path between antipodal points is undefined, so an error
+// matching ErrDomain is returned for a segment between antipodal or nearly
+// antipodal points. Add an intermediate point to choose the path.
+func LineToCells(points []LatLng, resolution int, bufferK ...int) ([]Cell, error) {
+	k := 0
+	if len(bufferK) > 0 {
+		k = bufferK[0]
+	}
+	if k < 0 {
+		return nil, toOpErr(C.E_DOMAIN, "LineToCells", resolution, k)
+	}
+	if len(points) == 0 {
+		return nil, nil
+	}
+
+	l := lineTracer{resolution: resolution, seen: make(CellSet)}
+
+	start, err := LatLngToCell(points[0], resolution)
+	if err != nil {
+		return nil, err
+	}
+	l.add(start)
+
+	for i := 1; i < len(points); i++ {
+		if start, err = l.traceSegment(points[i-1], points[i], start); err != nil {
+			return nil, err
+		}
+	}
+
+	if k == 0 {
+		return l.cells, nil
+	}
+
+	line := l.cells
+	for _, c := range line {
+		disk, err := c.GridDisk(k)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range disk {
+			l.add(n)
+		}
+	}
+
+	return l.cells, nil
+}
+
+// lineTracer accumulates the cells of a line without duplicates.
+type lineTracer struct {
+	resolution int
+	seen       CellSet
+	cells      []Cell
+}
+
+func (l *lineTracer) add(c Cell) {
+	if !l.seen.Contains(c) {
+		l.seen.Add(c)
+		l.cells = append(l.cells, c)
+	}
+}
+
+// traceSegment adds the cells from a, whose cell is from, to b, and returns
+// the cell of b.
+func (l *lineTracer) traceSegment(a, b LatLng, from Cell) (Cell, error) {
+	if nearlyAntipodal(a, b) {
+		return 0, toOpErr(C.E_DOMAIN, "LineToCells", a, b, l.resolution)
+	}
+
+	ca, cb := a.toC(), b.toC()
+
+	var estimate C.int64_t
+	errC := C.lineHexEstimate(&ca, &cb, C.int(l.resolution), &estimate)
+	if err := toOpErr(errC, "LineToCells", a, b, l.resolution); err != nil {
+		return 0, err
+	}
+
+	n := max(int(estimate), 1)
+	prev := a
+	for i := 1; i <= n; i++ {
+		next := GreatCircleInterpolate(a, b, float64(i)/float64(n))
+
+		to, err := LatLngToCell(next, l.resolution)
+		if err != nil {
+			return 0, err
+		}
+		if err := l.connect(prev, next, from, to, maxLineBisections); err != nil {
+			return 0, err
+		}
+
+		prev, from = next, to
+	}
+
+	return from, nil
+}
+
+// connect adds the cells between from, the cell of a, and to, the cell of b,
+// ending with to.
+func (l *lineTracer) connect(a, b LatLng, from, to Cell, bisections int) error {
+	if from == to {
+		return nil
+	}
+
+	if neighbors, err := from.IsNeighbor(to); err != nil {
+		return err
+	} else if neighbors {
+		l.add(to)
+		return nil
+	}
+
+	if path, err := GridPath(from, to); err == nil {
+		for _, c := range path[1:] {
+			l.add(c)
+		}
+
+		return nil
+	}
+
+	if bisections == 0 {
+		l.add(to)
+		return nil
+	}
+
+	mid := GreatCircleInterpolate(a, b, 0.5) //nolint:mnd // midpoint
+	c, err := LatLngToCell(mid, l.resolution)
+	if err != nil {
+		return err
+	}
+	if err := l.connect(a, mid, from, c, bisections-1); err != nil {
+		return err
+	}
+
+	return l.connect(mid, b, c, to, bisections-1)
+}
+
+// nearlyAntipodal reports whether a and b are within lineAntipodalEpsRads of
+// being antipodal. It compares unit vectors, since great-circle distances lose
+// precision close to half a great circle.
+func nearlyAntipodal(a, b LatLng) bool {
+	va, vb := unitVector(a), unitVector(b)
+	sum := [3]float64{va[0] + vb[0], va[1] + vb[1], va[2] + vb[2]}
+
+	// |va + vb| is 2*cos(d/2), which is close to pi - d near antipodes.
+	return math.Sqrt(dot(sum, sum)) < lineAntipodalEpsRads
+}
@@ -0,0 +1,154 @@
+/*
+ * Copyright 2026 Uber Technologies, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *         http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package h3
+
+import (
+	"math"
+	"testing"
+)
+
+func TestLineToCells(t *testing.T) {
+	t.Parallel()
+
+	t.Run("segment", func(t *testing.T) {
+		t.Parallel()
+
+		cells, err := LineToCells([]LatLng{validLatLng1, validLatLng2}, 9)
+		assertNoErr(t, err)
+		assertLineChain(t, cells)
+
+		first, _ := LatLngToCell(validLatLng1, 9)
+		last, _ := LatLngToCell(validLatLng2, 9)
+		assertEqual(t, first, cells[0])
+		assertEqual(t, last, cells[len(cells)-1])
+		assertEqual(t, len(cells), NewCellSet(cells...).Len())
+	})
+
+	t.Run("pentagon", func(t *testing.T) {
+		t.Parallel()
+
+		// GridPath cannot cross the pentagon at the center of this line.
+		center, _ := CellToLatLng(pentagonCell)
+		a := center.DestinationKm(45, 150)
+		b := center.DestinationKm(225, 150)
+
+		ca, _ := LatLngToCell(a, 5)
+		cb, _ := LatLngToCell(b, 5)
+		_, err := GridPath(ca, cb)
+		assertErr(t, err)
+
+		cells, err := LineToCells([]LatLng{a, b}, 5)
+		assertNoErr(t, err)
+		assertLineChain(t, cells)
+		assertEqual(t, ca, cells[0])
+		assertEqual(t, cb, cells[len(cells)-1])
+	})
+
+	t.Run("revisits", func(t *testing.T) {
+		t.Parallel()
+
+		there, err := LineToCells([]LatLng{validLatLng1, validLatLng2}, 8)
+		assertNoErr(t, err)
+		back, err := LineToCells([]LatLng{validLatLng1, validLatLng2, validLatLng1}, 8)
+		assertNoErr(t, err)
+
+		// The way back visits the same cells, give or take where the line runs
+		// along cell edges, and only new cells are appended.
+		assertEqualCells(t, there, back[:len(there)])
+		assertTrue(t, len(back)-len(there) < len(there)/100)
+		assertEqual(t, len(back), NewCellSet(back...).Len())
+	})
+
+	t.Run("buffer", func(t *testing.T) {
+		t.Parallel()
+
+		line, err := LineToCells([]LatLng{validLatLng1, validLatLng2}, 8)
+		assertNoErr(t, err)
+		buffered, err := LineToCells([]LatLng{validLatLng1, validLatLng2}, 8, 2)
+		assertNoErr(t, err)
+
+		want := NewCellSet()
+		for _, c := range line {
+			disk, _ := c.GridDisk(2)
+			want.Add(disk...)
+		}
+		assertEqualCells(t, line, buffered[:len(line)])
+		assertEqualCells(t, want.Cells(), NewCellSet(buffered...).Cells())
+		assertEqual(t, want.Len(), len(buffered))
+	})
+
+	t.Run("single point", func(t *testing.T) {
+		t.Parallel()
+
+		cells, err := LineToCells([]LatLng{validLatLng1}, 9)
+		assertNoErr(t, err)
+		c, _ := LatLngToCell(validLatLng1, 9)
+		assertEqualCells(t, []Cell{c}, cells)
+
+		cells, err = LineToCells(nil, 9)
+		assertNoErr(t, err)
+		assertNil(t, cells)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := LineToCells([]LatLng{validLatLng1, validLatLng2}, MaxResolution+1)
+		assertErrIs(t, err, ErrResolutionDomain)
+		_, err = LineToCells([]LatLng{validLatLng1, validLatLng2}, 9, -1)
+		assertErrIs(t, err, ErrDomain)
+	})
+
+	t.Run("antipodal", func(t *testing.T) {
+		t.Parallel()
+
+		for _, points := range [][]LatLng{
+			{{Lat: 0, Lng: 0}, {Lat: 0, Lng: 180}},
+			{{Lat: 10, Lng: 20}, {Lat: -10, Lng: -160}},
+			{{Lat: 90, Lng: 0}, {Lat: -90, Lng: 0}},
+			{validLatLng1, {Lat: 0, Lng: 0}, {Lat: 0, Lng: 180 - 1e-9}},
+		} {
+			_, err := LineToCells(points, 3)
+			assertErrIs(t, err, ErrDomain)
+		}
+
+		// Slightly short of antipodal, the path is defined.
+		_, err := LineToCells([]LatLng{{Lat: 0, Lng: 0}, {Lat: 0, Lng: 180 - 1e-6}}, 3)
+		assertNoErr(t, err)
+
+		// An intermediate point picks the path.
+		cells, err := LineToCells([]LatLng{{Lat: 0, Lng: 0}, {Lat: 0, Lng: 90}, {Lat: 0, Lng: 180}}, 3)
+		assertNoErr(t, err)
+		assertLineChain(t, cells)
+		for _, c := range cells {
+			g, err := c.LatLng()
+			assertNoErr(t, err)
+			assertTrue(t, g.Lng >= -1 && math.Abs(g.Lat) < 2)
+		}
+	})
+}
+
+func assertLineChain(t *testing.T, cells []Cell) {
+	t.Helper()
+
+	assertTrue(t, len(cells) > 1)
+	for i := 1; i < len(cells); i++ {
+		neighbors, err := cells[i-1].IsNeighbor(cells[i])
+		assertNoErr(t, err)
+		assertTrue(t, neighbors)
+	}
+}
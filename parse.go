@@ -0,0 +1,271 @@
+/*
+ * Copyright 2026 Uber Technologies, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *         http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package h3
+
+/*
+#include <h3_h3api.h>
+#include <h3_baseCells.h>
+*/
+import "C"
+
+import (
+	"fmt"
+	"strconv"
+)
+
+const (
+	// minIndexDigits and maxIndexDigits bound the number of hexadecimal
+	// digits of a valid index. Every mode sets a bit in the 15th digit from
+	// the right.
+	minIndexDigits = 15
+	maxIndexDigits = 16
+
+	// anyIndexMode asks parseIndexBits to accept any mode, validating the
+	// rest of the index according to the mode found.
+	anyIndexMode IndexMode = -1
+)
+
+// compile time checks that ensure interface implementation
+var (
+	_ AnyIndex = Cell(0)
+	_ AnyIndex = DirectedEdge(0)
+	_ AnyIndex = UndirectedEdge(0)
+	_ AnyIndex = Vertex(0)
+)
+
+// ParseError describes why a string is not a valid index.
+//
+// A ParseError matches the sentinel error for the index type being parsed,
+// such as ErrCellInvalid, or ErrIndexInvalid for ParseIndex, with errors.Is.
+type ParseError struct {
+	// Input is the string being parsed.
+	Input string
+	// Offset is the byte offset in Input of the first character at fault. For
+	// a field spanning several characters, it is the character holding the
+	// field's highest bit.
+	Offset int
+	// Reason describes the problem, such as "index mode 2, want 1".
+	Reason string
+	// Err is the sentinel error for the index type.
+	Err error
+}
+
+// Error returns the input, the offset and the reason, for example
+// `parsing "850dab63ffffff": offset 14: too short: 14 hexadecimal digits, want
+// 15 or 16`.
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("parsing %q: offset %d: %s", e.Input, e.Offset, e.Reason)
+}
+
+// Unwrap returns the sentinel error for the index type.
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// ParseCell returns the cell represented by the hexadecimal string, which may
+// have a 0x prefix. Unlike CellFromString, it returns a *ParseError for any
+// string that is not a valid cell.
+func ParseCell(s string) (Cell, error) {
+	return parseIndexString[Cell](s, CellMode)
+}
+
+// ParseDirectedEdge returns the directed edge represented by the hexadecimal
+// string, or a *ParseError if it is not a valid directed edge.
+func ParseDirectedEdge(s string) (DirectedEdge, error) {
+	return parseIndexString[DirectedEdge](s, DirectedEdgeMode)
+}
+
+// ParseUndirectedEdge returns the undirected edge represented by the
+// hexadecimal string, or a *ParseError if it is not a valid undirected edge.
+func ParseUndirectedEdge(s string) (UndirectedEdge, error) {
+	return parseIndexString[UndirectedEdge](s, UndirectedEdgeMode)
+}
+
+// ParseVertex returns the vertex represented by the hexadecimal string, or a
+// *ParseError if it is not a valid vertex.
+func ParseVertex(s string) (Vertex, error) {
+	return parseIndexString[Vertex](s, VertexMode)
+}
+
+// ParseIndex returns the index represented by the hexadecimal string as a
+// Cell, DirectedEdge, UndirectedEdge or Vertex, according to its mode bits.
+// It applies the same checks as ParseCell and the other Parse functions, and
+// returns a *ParseError matching ErrIndexInvalid if the string is not a valid
+// index of any mode.
+func ParseIndex(s string) (AnyIndex, error) {
+	h, err := parseIndexBits(s, anyIndexMode)
+	if err != nil {
+		return nil, err
+	}
+
+	return anyIndex(h), nil
+}
+
+// ParseIndexUint64 returns the index as a Cell, DirectedEdge, UndirectedEdge
+// or Vertex, according to its mode bits. An error matching ErrIndexInvalid is
+// returned if it is not a valid index of any mode.
+func ParseIndexUint64(h uint64) (AnyIndex, error) {
+	index := anyIndex(h)
+	if index == nil || !index.IsValid() {
+		return nil, toOpErr(C.E_INDEX_INVALID, "ParseIndexUint64", IndexToString(h))
+	}
+
+	return index, nil
+}
+
+// parseIndexString parses and validates an index of the mode.
+func parseIndexString[I validIndex](s string, mode IndexMode) (I, error) {
+	h, err := parseIndexBits(s, mode)
+	return I(h), err
+}
+
+// parseIndexBits parses and validates an index of the mode, or of any mode
+// for anyIndexMode, reporting the first problem found from the most
+// significant bit down.
+func parseIndexBits(s string, mode IndexMode) (uint64, error) {
+	errC := invalidIndexError(mode)
+	fail := func(offset int, format string, args ...any) (uint64, error) {
+		return 0, &ParseError{
+			Input:  s,
+			Offset: offset,
+			Reason: fmt.Sprintf(format, args...),
+			Err:    toErr(errC),
+		}
+	}
+
+	start := 0
+	if len(s) >= 2 && s[0] == '0' && (s[1] == 'x' || s[1] == 'X') {
+		start = 2
+	}
+	digits := s[start:]
+
+	for i := range len(digits) {
+		if !isHexDigit(digits[i]) {
+			return fail(start+i, "invalid hexadecimal digit %q", digits[i])
+		}
+	}
+
+	switch {
+	case len(digits) < minIndexDigits:
+		return fail(len(s), "too short: %d hexadecimal digits, want %d or %d", len(digits), minIndexDigits, maxIndexDigits)
+	case len(digits) > maxIndexDigits:
+		return fail(start+maxIndexDigits, "too long: %d hexadecimal digits, want %d or %d", len(digits), minIndexDigits, maxIndexDigits)
+	}
+
+	h, _ := strconv.ParseUint(digits, base16, bitSize)
+	p := DecomposeIndex(Cell(h))
+
+	// offset returns the position in s of the digit holding the bit.
+	offset := func(bit int) int {
+		return max(start, start+len(digits)-maxIndexDigits+(bitSize-1-bit)/4) //nolint:mnd // bits per digit
+	}
+
+	if p.HighBit != 0 {
+		return fail(offset(highBitOffset), "high bit is set")
+	}
+
+	modeBit := offset(modeOffset + 3) //nolint:mnd // top bit of the mode
+	if mode == anyIndexMode {
+		if p.Mode < CellMode || p.Mode > VertexMode {
+			return fail(modeBit, "index mode %d, want %d to %d", p.Mode, CellMode, VertexMode)
+		}
+		mode = p.Mode
+	}
+	if p.Mode != mode {
+		return fail(modeBit, "index mode %d, want %d", p.Mode, mode)
+	}
+
+	reservedBit := offset(reservedOffset + 2) //nolint:mnd // top bit of the reserved bits
+	switch mode {
+	case CellMode:
+		if p.Reserved != 0 {
+			return fail(reservedBit, "reserved bits are %d, want 0", p.Reserved)
+		}
+	case DirectedEdgeMode, UndirectedEdgeMode:
+		if !Direction(p.Reserved).IsValid() {
+			return fail(reservedBit, "edge direction %d, want %d to %d", p.Reserved, KAxesDirection, IJAxesDirection)
+		}
+	case VertexMode:
+		if p.Reserved >= C.NUM_HEX_VERTS {
+			return fail(reservedBit, "vertex number %d, want 0 to %d", p.Reserved, C.NUM_HEX_VERTS-1)
+		}
+	}
+
+	if p.BaseCell >= NumBaseCells {
+		return fail(offset(baseCellOffset+6), "base cell %d, want 0 to %d", p.BaseCell, NumBaseCells-1) //nolint:mnd // top bit of the base cell
+	}
+
+	pentagon := C._isBaseCellPentagon(C.int(p.BaseCell)) != 0
+	leading := true
+	for r := 1; r <= MaxResolution; r++ {
+		d := p.Digits[r-1]
+		at := offset(digitShift(r) + 2) //nolint:mnd // top bit of the digit
+
+		switch {
+		case r <= p.Resolution && d == invalidDigit:
+			return fail(at, "digit %d at resolution %d, want 0 to 6", d, r)
+		case r > p.Resolution && d != invalidDigit:
+			return fail(at, "digit %d at resolution %d finer than resolution %d, want 7", d, r, p.Resolution)
+		case r <= p.Resolution && pentagon && leading && d == int(KAxesDirection):
+			return fail(at, "digit 1 at resolution %d is deleted from pentagon base cell %d", r, p.BaseCell)
+		}
+
+		leading = leading && d == 0
+	}
+
+	if !isValidIndex(h) {
+		return fail(start, "%s", invalidIndexReason(mode))
+	}
+
+	return h, nil
+}
+
+// anyIndex returns h as the Index type of its mode, or nil for an unknown
+// mode. It does not validate h.
+func anyIndex(h uint64) AnyIndex {
+	switch IndexMode(h >> modeOffset & modeMask) {
+	case CellMode:
+		return Cell(h)
+	case DirectedEdgeMode:
+		return DirectedEdge(h)
+	case UndirectedEdgeMode:
+		return UndirectedEdge(h)
+	case VertexMode:
+		return Vertex(h)
+	default:
+		return nil
+	}
+}
+
+// invalidIndexReason explains why an index with valid fields can still be
+// invalid.
+func invalidIndexReason(mode IndexMode) string {
+	switch mode {
+	case DirectedEdgeMode:
+		return "no edge in this direction from a pentagon"
+	case UndirectedEdgeMode:
+		return "not the canonical edge between its cells"
+	case VertexMode:
+		return "not the canonical vertex or no such vertex on a pentagon"
+	default:
+		return "not a valid index"
+	}
+}
+
+func isHexDigit(c byte) bool {
+	return '0' <= c && c <= '9' || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F'
+}
@@ -0,0 +1,262 @@
+/*
+ * Copyright 2026 Uber Technologies, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *         http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package h3
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestParseCell(t *testing.T) {
+	t.Parallel()
+
+	for _, s := range []string{
+		"850dab63fffffff",
+		"0850dab63fffffff",
+		"0x850dab63fffffff",
+		"0X850DAB63FFFFFFF",
+	} {
+		c, err := ParseCell(s)
+		assertNoErr(t, err)
+		assertEqual(t, validCell, c)
+	}
+
+	c, err := ParseCell(pentagonCell.String())
+	assertNoErr(t, err)
+	assertEqual(t, pentagonCell, c)
+
+	testCases := []struct {
+		name   string
+		input  string
+		offset int
+		reason string
+	}{
+		{"empty", "", 0, "too short"},
+		{"prefix only", "0x", 2, "too short"},
+		{"too short", "850dab63ffffff", 14, "too short: 14 hexadecimal digits"},
+		{"too long", "850dab63fffffff00", 16, "too long: 17 hexadecimal digits"},
+		{"too long with prefix", "0x850dab63fffffff00", 18, "too long"},
+		{"invalid hex", "850dab63fffffzf", 13, `invalid hexadecimal digit 'z'`},
+		{"invalid hex with prefix", "0x850dab63fffffzf", 15, `invalid hexadecimal digit 'z'`},
+		{"sign", "-850dab63fffffff", 0, `invalid hexadecimal digit '-'`},
+		{"high bit", "8850dab63fffffff", 0, "high bit is set"},
+		{"directed edge", validEdge.String(), 0, "index mode 2, want 1"},
+		{"vertex", validVertex.String(), 0, "index mode 4, want 1"},
+		{"reserved bits", "950dab63fffffff", 0, "reserved bits are 1, want 0"},
+		{"base cell", "85ffab63fffffff", 2, "base cell 127, want 0 to 121"},
+		{"digit 7", "850deb63fffffff", 3, "digit 7 at resolution 1"},
+		{"digit beyond resolution", "850dab607ffffff", 7, "digit 0 at resolution 6 finer than resolution 5"},
+		{"deleted pentagon digit", "821c47fffffffff", 3, "digit 1 at resolution 1 is deleted from pentagon base cell 14"},
+		{"deleted pentagon digit after zero", "821c0ffffffffff", 4, "digit 1 at resolution 2 is deleted"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			c, err := ParseCell(tc.input)
+			assertErrIs(t, err, ErrCellInvalid)
+			assertEqual(t, Cell(0), c)
+
+			var pe *ParseError
+			if !errors.As(err, &pe) {
+				t.Fatalf("error %v is not a *ParseError", err)
+			}
+			assertEqual(t, tc.input, pe.Input)
+			assertEqual(t, tc.offset, pe.Offset)
+			if !strings.Contains(pe.Reason, tc.reason) {
+				t.Errorf("reason %q does not contain %q", pe.Reason, tc.reason)
+			}
+		})
+	}
+}
+
+func TestParseDirectedEdge(t *testing.T) {
+	t.Parallel()
+
+	e, err := ParseDirectedEdge(validEdge.String())
+	assertNoErr(t, err)
+	assertEqual(t, validEdge, e)
+
+	_, err = ParseDirectedEdge(validCell.String())
+	assertErrIs(t, err, ErrDirectedEdgeInvalid)
+	assertEqual(t, `parsing "850dab63fffffff": offset 0: index mode 1, want 2`, err.Error())
+
+	_, err = ParseDirectedEdge("1050dab73fffffff")
+	assertErrIs(t, err, ErrDirectedEdgeInvalid)
+	assertEqual(t, `parsing "1050dab73fffffff": offset 1: edge direction 0, want 1 to 6`, err.Error())
+
+	_, err = ParseDirectedEdge("1750dab73fffffff")
+	assertErrIs(t, err, ErrDirectedEdgeInvalid)
+
+	// The k-axes edge of a pentagon has well-formed fields but does not exist.
+	_, err = ParseDirectedEdge("1121c07fffffffff")
+	assertErrIs(t, err, ErrDirectedEdgeInvalid)
+	assertEqual(t, `parsing "1121c07fffffffff": offset 0: no edge in this direction from a pentagon`, err.Error())
+}
+
+func TestParseUndirectedEdge(t *testing.T) {
+	t.Parallel()
+
+	u, err := validEdge.UndirectedEdge()
+	assertNoErr(t, err)
+
+	parsed, err := ParseUndirectedEdge(u.String())
+	assertNoErr(t, err)
+	assertEqual(t, u, parsed)
+
+	edges, err := u.DirectedEdges()
+	assertNoErr(t, err)
+
+	// Only one of the two directed edges between the cells is canonical.
+	var rejected int
+	for _, e := range edges {
+		h := uint64(e)&^(modeMask<<modeOffset) | uint64(UndirectedEdgeMode)<<modeOffset
+		if UndirectedEdge(h) == u {
+			continue
+		}
+
+		_, err := ParseUndirectedEdge(IndexToString(h))
+		assertErrIs(t, err, ErrUndirectedEdgeInvalid)
+		assertTrue(t, strings.HasSuffix(err.Error(), "not the canonical edge between its cells"))
+		rejected++
+	}
+	assertEqual(t, 1, rejected)
+
+	_, err = ParseUndirectedEdge(validEdge.String())
+	assertErrIs(t, err, ErrUndirectedEdgeInvalid)
+}
+
+func TestParseVertex(t *testing.T) {
+	t.Parallel()
+
+	v, err := ParseVertex(validVertex.String())
+	assertNoErr(t, err)
+	assertEqual(t, validVertex, v)
+
+	_, err = ParseVertex("2650dab63fffffff")
+	assertErrIs(t, err, ErrVertexInvalid)
+	assertEqual(t, `parsing "2650dab63fffffff": offset 1: vertex number 6, want 0 to 5`, err.Error())
+
+	_, err = ParseVertex(validCell.String())
+	assertErrIs(t, err, ErrVertexInvalid)
+}
+
+func TestUnmarshalTextParseError(t *testing.T) {
+	t.Parallel()
+
+	c := validCell
+	err := c.UnmarshalText([]byte("850dab63ffffff"))
+
+	var pe *ParseError
+	assertTrue(t, errors.As(err, &pe))
+	assertEqual(t, 14, pe.Offset)
+	assertErrIs(t, err, ErrCellInvalid)
+	assertEqual(t, validCell, c)
+
+	var e DirectedEdge
+	assertErrIs(t, e.UnmarshalText([]byte(validCell.String())), ErrDirectedEdgeInvalid)
+
+	var u UndirectedEdge
+	assertErrIs(t, u.UnmarshalText([]byte(validEdge.String())), ErrUndirectedEdgeInvalid)
+
+	var v Vertex
+	assertErrIs(t, v.UnmarshalText([]byte("2650dab63fffffff")), ErrVertexInvalid)
+}
+
+func TestParseIndex(t *testing.T) {
+	t.Parallel()
+
+	u, err := validEdge.UndirectedEdge()
+	assertNoErr(t, err)
+
+	for _, want := range []AnyIndex{validCell, pentagonCell, validEdge, u, validVertex} {
+		index, err := ParseIndex(want.String())
+		assertNoErr(t, err)
+		assertEqual(t, want, index)
+
+		index, err = ParseIndex("0x" + want.String())
+		assertNoErr(t, err)
+		assertEqual(t, want, index)
+
+		index, err = ParseIndexUint64(IndexFromString(want.String()))
+		assertNoErr(t, err)
+		assertEqual(t, want, index)
+	}
+
+	index, err := ParseIndex(validEdge.String())
+	assertNoErr(t, err)
+
+	switch index := index.(type) {
+	case DirectedEdge:
+		assertEqual(t, validEdge, index)
+	default:
+		t.Fatalf("ParseIndex(%q) returned %T, want DirectedEdge", validEdge.String(), index)
+	}
+
+	assertEqual(t, validCell.Resolution(), index.Resolution())
+	assertTrue(t, index.IsValid())
+	digit, err := index.IndexDigit(1)
+	assertNoErr(t, err)
+	assertEqual(t, 6, digit)
+}
+
+func TestParseIndexInvalid(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name   string
+		input  string
+		offset int
+		reason string
+	}{
+		{"too short", "850dab63ffffff", 14, "too short"},
+		{"invalid hex", "850dab63fffffzf", 13, `invalid hexadecimal digit 'z'`},
+		{"mode 0", "050dab63fffffff", 0, "index mode 0, want 1 to 4"},
+		{"mode 5", "2850dab63fffffff", 0, "index mode 5, want 1 to 4"},
+		{"cell digit", "850deb63fffffff", 3, "digit 7 at resolution 1"},
+		{"edge direction", "1050dab73fffffff", 1, "edge direction 0, want 1 to 6"},
+		{"vertex number", "2650dab63fffffff", 1, "vertex number 6, want 0 to 5"},
+		{"pentagon edge", "1121c07fffffffff", 0, "no edge in this direction from a pentagon"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			index, err := ParseIndex(tc.input)
+			assertErrIs(t, err, ErrIndexInvalid)
+			assertTrue(t, index == nil)
+
+			var pe *ParseError
+			if !errors.As(err, &pe) {
+				t.Fatalf("error %v is not a *ParseError", err)
+			}
+			assertEqual(t, tc.offset, pe.Offset)
+			if !strings.Contains(pe.Reason, tc.reason) {
+				t.Errorf("reason %q does not contain %q", pe.Reason, tc.reason)
+			}
+
+			_, err = ParseIndexUint64(IndexFromString(tc.input))
+			assertErrIs(t, err, ErrIndexInvalid)
+		})
+	}
+
+	_, err := ParseIndexUint64(0)
+	assertErrIs(t, err, ErrIndexInvalid)
+}
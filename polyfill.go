@@ -0,0 +1,98 @@
+/*
+ * Copyright 2026 Uber Technologies, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *         http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package h3
+
+/*
+#include <h3_h3api.h>
+#include <h3_polygon.h>
+#include <h3_polyfill.h>
+*/
+import "C"
+
+// PolygonToInteriorBoundaryCells splits the cells at resolution overlapping
+// the polygon into those entirely inside it and those crossing its edge.
+//
+// interior holds the cells PolygonToCellsExperimental returns with
+// ContainmentFull, and boundary holds the remaining cells it returns with
+// ContainmentOverlapping. Points in an interior cell are always inside the
+// polygon, while points in a boundary cell need an exact check such as
+// GeoPolygon.Contains.
+//
+// Both sets are produced by a single traversal of the polygon. If compact is
+// true, each set is compacted as CellSet.Compact does, so interior may hold
+// cells coarser than resolution.
+func PolygonToInteriorBoundaryCells(polygon GeoPolygon, resolution int, compact bool) (interior, boundary []Cell, err error) {
+	if len(polygon.GeoLoop) == 0 {
+		return nil, nil, nil
+	}
+
+	cpoly := mallocCGeoPolygon(polygon)
+	defer freeMallocCGeoPolygon(cpoly)
+
+	it := C.iterInitPolygonCompact(cpoly, C.int(resolution), C.CONTAINMENT_OVERLAPPING)
+	defer C.iterDestroyPolygonCompact(&it)
+
+	for ; it.cell != C.H3_NULL; C.iterStepPolygonCompact(&it) {
+		c := Cell(it.cell)
+
+		// Coarser cells are only produced when all of their descendants are
+		// inside the polygon.
+		if c.Resolution() < resolution {
+			if compact {
+				interior = append(interior, c)
+				continue
+			}
+
+			children, err := c.Children(resolution)
+			if err != nil {
+				return nil, nil, err
+			}
+			interior = append(interior, children...)
+
+			continue
+		}
+
+		var (
+			cb   C.CellBoundary
+			bbox C.BBox
+		)
+		errC := C.cellToBoundary(it.cell, &cb)
+		if errC == C.E_SUCCESS {
+			errC = C.cellToBBox(it.cell, &bbox, false)
+		}
+		if err := toOpErr(errC, "PolygonToInteriorBoundaryCells", resolution, compact); err != nil {
+			return nil, nil, err
+		}
+
+		if C.cellBoundaryInsidePolygon(it._polygon, it._bboxes, &cb, &bbox) {
+			interior = append(interior, c)
+		} else {
+			boundary = append(boundary, c)
+		}
+	}
+
+	if err := toOpErr(it.error, "PolygonToInteriorBoundaryCells", resolution, compact); err != nil {
+		return nil, nil, err
+	}
+
+	if compact {
+		interior = NewCellSet(interior...).Compact().Cells()
+		boundary = NewCellSet(boundary...).Compact().Cells()
+	}
+
+	return interior, boundary, nil
+}
@@ -0,0 +1,60 @@
+/*
+ * Copyright 2026 Uber Technologies, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *         http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package h3
+
+import "testing"
+
+func TestPolygonToInteriorBoundaryCells(t *testing.T) {
+	t.Parallel()
+
+	for _, res := range []int{7, 9} {
+		full, err := PolygonToCellsExperimental(validGeoPolygonHoles, res, ContainmentFull)
+		assertNoErr(t, err)
+		overlapping, err := PolygonToCellsExperimental(validGeoPolygonHoles, res, ContainmentOverlapping)
+		assertNoErr(t, err)
+		edge := NewCellSet(overlapping...).Difference(NewCellSet(full...)).Cells()
+
+		interior, boundary, err := PolygonToInteriorBoundaryCells(validGeoPolygonHoles, res, false)
+		assertNoErr(t, err)
+		assertEqualCells(t, full, interior)
+		assertEqualCells(t, edge, boundary)
+
+		interior, boundary, err = PolygonToInteriorBoundaryCells(validGeoPolygonHoles, res, true)
+		assertNoErr(t, err)
+		assertEqualCells(t, NewCellSet(full...).Compact().Cells(), interior)
+		assertEqualCells(t, NewCellSet(edge...).Compact().Cells(), boundary)
+	}
+
+	// A large polygon produces coarse interior cells from the traversal.
+	interior, _, err := PolygonToInteriorBoundaryCells(GeoPolygon{GeoLoop: transmeridianGeoLoop}, 4, true)
+	assertNoErr(t, err)
+	coarse := 0
+	for _, c := range interior {
+		if c.Resolution() < 4 {
+			coarse++
+		}
+	}
+	assertTrue(t, coarse > 0)
+
+	interior, boundary, err := PolygonToInteriorBoundaryCells(GeoPolygon{}, 9, false)
+	assertNoErr(t, err)
+	assertEqual(t, 0, len(interior))
+	assertEqual(t, 0, len(boundary))
+
+	_, _, err = PolygonToInteriorBoundaryCells(validGeoPolygonHoles, MaxResolution+1, false)
+	assertErrIs(t, err, ErrResolutionDomain)
+}
@@ -0,0 +1,113 @@
+/*
+ * Copyright 2026 Uber Technologies, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *         http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package h3
+
+/*
+#include <h3_h3api.h>
+#include <h3_bbox.h>
+#include <h3_polygon.h>
+*/
+import "C"
+
+import "slices"
+
+// Contains returns whether the point is inside the loop, using the same
+// point-in-polygon test as PolygonToCells. Loops are treated as flat in
+// latitude and longitude, and arcs spanning more than 180 degrees of longitude
+// are interpreted as crossing the antimeridian.
+func (l GeoLoop) Contains(point LatLng) bool {
+	if len(l) == 0 {
+		return false
+	}
+
+	cloop := allocCGeoLoop(l)
+	defer freeCGeoLoop(&cloop)
+
+	var bbox C.BBox
+	C.bboxFromGeoLoop(&cloop, &bbox)
+
+	cp := point.toC()
+
+	return bool(C.pointInsideGeoLoop(&cloop, &bbox, &cp))
+}
+
+// IsClockwise returns whether the vertexes of the loop are in clockwise order.
+// In GeoJSON, outer loops are counterclockwise and holes are clockwise.
+func (l GeoLoop) IsClockwise() bool {
+	if len(l) == 0 {
+		return false
+	}
+
+	cloop := allocCGeoLoop(l)
+	defer freeCGeoLoop(&cloop)
+
+	return bool(C.isClockwiseGeoLoop(&cloop))
+}
+
+// Reverse returns a copy of the loop with its vertexes in reverse order.
+func (l GeoLoop) Reverse() GeoLoop {
+	out := slices.Clone(l)
+	slices.Reverse(out)
+
+	return out
+}
+
+// Contains returns whether the point is inside the polygon and outside all of
+// its holes. A cell is returned by PolygonToCells, or by
+// PolygonToCellsExperimental with ContainmentCenter, exactly when the polygon
+// contains the cell's center.
+//
+// Containment does not depend on the winding order of the loops.
+func (p GeoPolygon) Contains(point LatLng) bool {
+	if len(p.GeoLoop) == 0 {
+		return false
+	}
+
+	cpoly := allocCGeoPolygon(p)
+	defer freeCGeoPolygon(&cpoly)
+
+	bboxes := make([]C.BBox, len(p.Holes)+1)
+	C.bboxesFromGeoPolygon(&cpoly, &bboxes[0])
+
+	cp := point.toC()
+
+	return bool(C.pointInsidePolygon(&cpoly, &bboxes[0], &cp))
+}
+
+// Normalize returns a copy of the polygon whose outer loop is counterclockwise
+// and whose holes are clockwise, following the GeoJSON right-hand rule.
+func (p GeoPolygon) Normalize() GeoPolygon {
+	out := GeoPolygon{GeoLoop: normalizeLoop(p.GeoLoop, false)}
+
+	if p.Holes != nil {
+		out.Holes = make([]GeoLoop, len(p.Holes))
+		for i, hole := range p.Holes {
+			out.Holes[i] = normalizeLoop(hole, true)
+		}
+	}
+
+	return out
+}
+
+// normalizeLoop returns a copy of the loop wound in the requested direction.
+func normalizeLoop(l GeoLoop, clockwise bool) GeoLoop {
+	if len(l) > 0 && l.IsClockwise() != clockwise {
+		return l.Reverse()
+	}
+
+	return slices.Clone(l)
+}
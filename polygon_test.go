@@ -0,0 +1,122 @@
+/*
+ * Copyright 2026 Uber Technologies, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *         http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package h3
+
+import "testing"
+
+func TestGeoPolygon_Contains(t *testing.T) {
+	t.Parallel()
+
+	t.Run("agrees with PolygonToCells", func(t *testing.T) {
+		t.Parallel()
+
+		for _, polygon := range []GeoPolygon{validGeoPolygonNoHoles, validGeoPolygonHoles} {
+			centers, err := PolygonToCells(polygon, 9)
+			assertNoErr(t, err)
+
+			filled := make(map[Cell]bool, len(centers))
+			for _, c := range centers {
+				filled[c] = true
+			}
+
+			candidates, err := PolygonToCellsExperimental(polygon, 9, ContainmentOverlapping)
+			assertNoErr(t, err)
+			assertTrue(t, len(candidates) > len(centers))
+
+			for _, c := range candidates {
+				center, err := c.LatLng()
+				assertNoErr(t, err)
+				assertEqual(t, filled[c], polygon.Contains(center), c)
+			}
+		}
+	})
+
+	t.Run("holes", func(t *testing.T) {
+		t.Parallel()
+
+		inHole := NewLatLng(67.15, -168.35)
+		assertTrue(t, validGeoPolygonNoHoles.Contains(inHole))
+		assertFalse(t, validGeoPolygonHoles.Contains(inHole))
+		assertTrue(t, validGeoPolygonHoles.Contains(NewLatLng(67.15, -168.45)))
+	})
+
+	t.Run("transmeridian", func(t *testing.T) {
+		t.Parallel()
+
+		p := GeoPolygon{GeoLoop: transmeridianGeoLoop}
+		assertTrue(t, p.Contains(NewLatLng(0, 180)))
+		assertTrue(t, p.Contains(NewLatLng(5, -175)))
+		assertFalse(t, p.Contains(NewLatLng(0, 0)))
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		t.Parallel()
+
+		assertFalse(t, GeoPolygon{}.Contains(NewLatLng(0, 0)))
+		assertFalse(t, GeoLoop{}.Contains(NewLatLng(0, 0)))
+	})
+}
+
+func TestGeoLoop_Contains(t *testing.T) {
+	t.Parallel()
+
+	assertTrue(t, validHole1.Contains(NewLatLng(67.15, -168.35)))
+	assertFalse(t, validHole1.Contains(NewLatLng(67.15, -168.45)))
+	assertEqual(t,
+		validHole1.Contains(NewLatLng(67.15, -168.35)),
+		validHole1.Reverse().Contains(NewLatLng(67.15, -168.35)),
+	)
+}
+
+func TestGeoLoop_IsClockwise(t *testing.T) {
+	t.Parallel()
+
+	counterclockwise := GeoLoop{{Lat: 0, Lng: 0}, {Lat: 0, Lng: 1}, {Lat: 1, Lng: 1}, {Lat: 1, Lng: 0}}
+	assertFalse(t, counterclockwise.IsClockwise())
+	assertTrue(t, counterclockwise.Reverse().IsClockwise())
+
+	assertTrue(t, transmeridianGeoLoop.IsClockwise())
+	assertFalse(t, transmeridianGeoLoop.Reverse().IsClockwise())
+
+	assertFalse(t, GeoLoop{}.IsClockwise())
+}
+
+func TestGeoPolygon_Normalize(t *testing.T) {
+	t.Parallel()
+
+	p := GeoPolygon{
+		GeoLoop: validGeoLoop.Reverse(),
+		Holes:   []GeoLoop{validHole1, validHole1.Reverse(), {}},
+	}
+
+	n := p.Normalize()
+	assertFalse(t, n.GeoLoop.IsClockwise())
+	assertTrue(t, n.Holes[0].IsClockwise())
+	assertTrue(t, n.Holes[1].IsClockwise())
+	assertEqual(t, 0, len(n.Holes[2]))
+
+	// Normalizing twice is a no-op, and the input is not modified.
+	assertEqualLatLngs(t, n.GeoLoop, n.Normalize().GeoLoop)
+	assertEqualLatLngs(t, validGeoLoop.Reverse(), p.GeoLoop)
+
+	// Containment is unaffected.
+	for _, c := range []LatLng{NewLatLng(67.15, -168.35), NewLatLng(67.15, -168.45)} {
+		assertEqual(t, p.Contains(c), n.Contains(c))
+	}
+
+	assertEqual(t, 0, len(GeoPolygon{}.Normalize().GeoLoop))
+}
@@ -0,0 +1,146 @@
+/*
+ * Copyright 2026 Uber Technologies, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *         http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package h3
+
+import (
+	"maps"
+	"slices"
+)
+
+// ResampleExtensive converts values of an extensive quantity, such as a count
+// or a total, to cells at resolution while conserving the overall total.
+//
+// Values of cells finer than resolution are summed into their ancestor. Values
+// of coarser cells are divided between their descendants in proportion to
+// CellAreaM2, which accounts for pentagons and for cells of the same
+// resolution differing in size. The map may hold mixed resolutions; a cell
+// receiving values from several sources gets their sum.
+//
+// Resampling follows the logical hierarchy of Parent and Children. Because
+// children do not exactly tile their parent, a value is assigned to the
+// descendants of its cell rather than to the cells it geometrically overlaps.
+func ResampleExtensive[V ~float32 | ~float64](m CellMap[V], resolution int) (CellMap[V], error) {
+	out := make(CellMap[V])
+	split := SplitByArea[V]()
+
+	err := resample(m, resolution, func(c Cell, v V, parent Cell, children []Cell) error {
+		if children == nil {
+			out[parent] += v
+			return nil
+		}
+
+		shares, err := split(c, v, children)
+		if err != nil {
+			return err
+		}
+		for i, child := range children {
+			out[child] += shares[i]
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// ResampleIntensive converts values of an intensive quantity, such as a
+// density or a rate, to cells at resolution.
+//
+// Descendants of a coarser cell take its value. A cell receiving values from
+// several finer cells, or from overlapping cells in a map of mixed
+// resolutions, gets their mean weighted by CellAreaM2 of the part each source
+// contributes. Only cells present in the map contribute, so missing
+// descendants are treated as having no data rather than a value of zero.
+//
+// Like ResampleExtensive, resampling follows the logical hierarchy of Parent
+// and Children.
+func ResampleIntensive[V ~float32 | ~float64](m CellMap[V], resolution int) (CellMap[V], error) {
+	type weighted struct{ sum, area float64 }
+
+	acc := make(map[Cell]weighted)
+	add := func(c Cell, v V, area float64) {
+		w := acc[c]
+		w.sum += float64(v) * area
+		w.area += area
+		acc[c] = w
+	}
+
+	err := resample(m, resolution, func(c Cell, v V, parent Cell, children []Cell) error {
+		if children == nil {
+			area, err := CellAreaM2(c)
+			if err != nil {
+				return err
+			}
+			add(parent, v, area)
+
+			return nil
+		}
+
+		for _, child := range children {
+			area, err := CellAreaM2(child)
+			if err != nil {
+				return err
+			}
+			add(child, v, area)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(CellMap[V], len(acc))
+	for c, w := range acc {
+		out[c] = V(w.sum / w.area)
+	}
+
+	return out, nil
+}
+
+// resample calls visit for every cell of the map in index order, with either
+// its ancestor at resolution, for cells at or finer than resolution, or its
+// descendants at resolution, for coarser cells. children is nil in the former
+// case.
+func resample[V any](m CellMap[V], resolution int, visit func(c Cell, v V, parent Cell, children []Cell) error) error {
+	for _, c := range slices.Sorted(maps.Keys(m)) {
+		if c.Resolution() < resolution {
+			children, err := c.Children(resolution)
+			if err != nil {
+				return err
+			}
+			if err := visit(c, m[c], 0, children); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		parent, err := c.Parent(resolution)
+		if err != nil {
+			return err
+		}
+		if err := visit(c, m[c], parent, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+/*
+ * Copyright 2026 Uber Technologies, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *         http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package h3
+
+import "testing"
+
+func sumValues(m CellMap[float64]) float64 {
+	var sum float64
+	for _, v := range m {
+		sum += v
+	}
+
+	return sum
+}
+
+func TestResampleExtensive(t *testing.T) {
+	t.Parallel()
+
+	pentagon, _ := pentagonCell.CenterChild(4)
+	m := CellMap[float64]{validCell: 100, pentagon: 60}
+
+	finer, err := ResampleExtensive(m, 7)
+	assertNoErr(t, err)
+	assertEqual(t, 49+pentagonChildCount(3), len(finer))
+	assertEqualEps(t, 160, sumValues(finer))
+
+	// Pentagon children are not all the same size.
+	children, _ := pentagon.Children(7)
+	assertFalse(t, equalEps(finer[children[0]], finer[children[1]]))
+
+	back, err := ResampleExtensive(finer, 4)
+	assertNoErr(t, err)
+	assertEqualEps(t, 160, sumValues(back))
+	assertEqualEps(t, 60, back[pentagon])
+
+	parent, _ := validCell.Parent(4)
+	assertEqualEps(t, 100, back[parent])
+
+	// Mixed resolutions.
+	child, _ := validCell.CenterChild(6)
+	mixed, err := ResampleExtensive(CellMap[float64]{validCell: 70, child: 5}, 6)
+	assertNoErr(t, err)
+	assertEqualEps(t, 75, sumValues(mixed))
+	assertTrue(t, mixed[child] > 10)
+
+	_, err = ResampleExtensive(m, MaxResolution+1)
+	assertErrIs(t, err, ErrResolutionDomain)
+}
+
+func TestResampleIntensive(t *testing.T) {
+	t.Parallel()
+
+	m := CellMap[float64]{validCell: 3.5, pentagonCell: 1}
+
+	finer, err := ResampleIntensive(m, 6)
+	assertNoErr(t, err)
+	children, _ := validCell.Children(6)
+	for _, c := range children {
+		assertEqualEps(t, 3.5, finer[c])
+	}
+
+	back, err := ResampleIntensive(finer, 5)
+	assertNoErr(t, err)
+	assertEqualEps(t, 3.5, back[validCell])
+
+	// Coarsening weighs children by area.
+	a, b := children[0], children[1]
+	areaA, _ := CellAreaM2(a)
+	areaB, _ := CellAreaM2(b)
+	coarse, err := ResampleIntensive(CellMap[float64]{a: 1, b: 2}, 5)
+	assertNoErr(t, err)
+	assertEqual(t, 1, len(coarse))
+	assertEqualEps(t, (areaA+2*areaB)/(areaA+areaB), coarse[validCell])
+
+	_, err = ResampleIntensive(m, -1)
+	assertErrIs(t, err, ErrResolutionDomain)
+}
+
+// pentagonChildCount returns the number of descendants of a pentagon n
+// resolutions finer.
+func pentagonChildCount(n int) int {
+	return 1 + 5*(pow7[n]-1)/6
+}
@@ -0,0 +1,331 @@
+/*
+ * Copyright 2026 Uber Technologies, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *         http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package h3
+
+/*
+#include <h3_h3api.h>
+*/
+import "C"
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/binary"
+	"fmt"
+)
+
+// indexBinarySize is the size of an index encoded by MarshalBinary.
+const indexBinarySize = 8
+
+// compile time checks that ensure interface implementation
+var (
+	_ encoding.BinaryMarshaler   = (*Cell)(nil)
+	_ encoding.BinaryUnmarshaler = (*Cell)(nil)
+	_ encoding.BinaryMarshaler   = (*DirectedEdge)(nil)
+	_ encoding.BinaryUnmarshaler = (*DirectedEdge)(nil)
+	_ encoding.BinaryMarshaler   = (*UndirectedEdge)(nil)
+	_ encoding.BinaryUnmarshaler = (*UndirectedEdge)(nil)
+	_ encoding.BinaryMarshaler   = (*Vertex)(nil)
+	_ encoding.BinaryUnmarshaler = (*Vertex)(nil)
+
+	_ sql.Scanner   = (*Cell)(nil)
+	_ driver.Valuer = (*Cell)(nil)
+	_ sql.Scanner   = (*DirectedEdge)(nil)
+	_ driver.Valuer = (*DirectedEdge)(nil)
+	_ sql.Scanner   = (*UndirectedEdge)(nil)
+	_ driver.Valuer = (*UndirectedEdge)(nil)
+	_ sql.Scanner   = (*Vertex)(nil)
+	_ driver.Valuer = (*Vertex)(nil)
+
+	_ sql.Scanner   = (*NullCell)(nil)
+	_ driver.Valuer = (*NullCell)(nil)
+	_ sql.Scanner   = (*NullDirectedEdge)(nil)
+	_ driver.Valuer = (*NullDirectedEdge)(nil)
+	_ sql.Scanner   = (*NullUndirectedEdge)(nil)
+	_ driver.Valuer = (*NullUndirectedEdge)(nil)
+	_ sql.Scanner   = (*NullVertex)(nil)
+	_ driver.Valuer = (*NullVertex)(nil)
+)
+
+type (
+	// NullCell is a Cell that may be NULL in a database, like sql.NullInt64.
+	NullCell struct {
+		Cell  Cell
+		Valid bool // Valid is true if Cell is not NULL
+	}
+
+	// NullDirectedEdge is a DirectedEdge that may be NULL in a database.
+	NullDirectedEdge struct {
+		DirectedEdge DirectedEdge
+		Valid        bool // Valid is true if DirectedEdge is not NULL
+	}
+
+	// NullUndirectedEdge is an UndirectedEdge that may be NULL in a database.
+	NullUndirectedEdge struct {
+		UndirectedEdge UndirectedEdge
+		Valid          bool // Valid is true if UndirectedEdge is not NULL
+	}
+
+	// NullVertex is a Vertex that may be NULL in a database.
+	NullVertex struct {
+		Vertex Vertex
+		Valid  bool // Valid is true if Vertex is not NULL
+	}
+
+	// validIndex is an index type that can check its own validity.
+	validIndex interface {
+		Index
+		IsValid() bool
+	}
+)
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface. The index
+// is encoded as 8 bytes in big-endian order.
+func (c Cell) MarshalBinary() ([]byte, error) {
+	return marshalBinary(c)
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (c *Cell) UnmarshalBinary(data []byte) error {
+	return unmarshalBinary(c, data, CellMode, "Cell.UnmarshalBinary")
+}
+
+// Scan implements the sql.Scanner interface. It accepts integer columns, such
+// as a Postgres BIGINT, 8-byte binary columns as written by MarshalBinary and
+// hexadecimal text columns. Use NullCell for columns that may be NULL.
+func (c *Cell) Scan(src any) error {
+	return scanIndex(c, src, CellMode, "Cell.Scan")
+}
+
+// Value implements the driver.Valuer interface. The cell is stored as an
+// int64, which holds every index without loss.
+func (c Cell) Value() (driver.Value, error) {
+	return int64(c), nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface. The index
+// is encoded as 8 bytes in big-endian order.
+func (e DirectedEdge) MarshalBinary() ([]byte, error) {
+	return marshalBinary(e)
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (e *DirectedEdge) UnmarshalBinary(data []byte) error {
+	return unmarshalBinary(e, data, DirectedEdgeMode, "DirectedEdge.UnmarshalBinary")
+}
+
+// Scan implements the sql.Scanner interface, accepting the same columns as
+// Cell.Scan. Use NullDirectedEdge for columns that may be NULL.
+func (e *DirectedEdge) Scan(src any) error {
+	return scanIndex(e, src, DirectedEdgeMode, "DirectedEdge.Scan")
+}
+
+// Value implements the driver.Valuer interface. The edge is stored as an
+// int64.
+func (e DirectedEdge) Value() (driver.Value, error) {
+	return int64(e), nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface. The index
+// is encoded as 8 bytes in big-endian order.
+func (e UndirectedEdge) MarshalBinary() ([]byte, error) {
+	return marshalBinary(e)
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (e *UndirectedEdge) UnmarshalBinary(data []byte) error {
+	return unmarshalBinary(e, data, UndirectedEdgeMode, "UndirectedEdge.UnmarshalBinary")
+}
+
+// Scan implements the sql.Scanner interface, accepting the same columns as
+// Cell.Scan. Use NullUndirectedEdge for columns that may be NULL.
+func (e *UndirectedEdge) Scan(src any) error {
+	return scanIndex(e, src, UndirectedEdgeMode, "UndirectedEdge.Scan")
+}
+
+// Value implements the driver.Valuer interface. The edge is stored as an
+// int64.
+func (e UndirectedEdge) Value() (driver.Value, error) {
+	return int64(e), nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface. The index
+// is encoded as 8 bytes in big-endian order.
+func (v Vertex) MarshalBinary() ([]byte, error) {
+	return marshalBinary(v)
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (v *Vertex) UnmarshalBinary(data []byte) error {
+	return unmarshalBinary(v, data, VertexMode, "Vertex.UnmarshalBinary")
+}
+
+// Scan implements the sql.Scanner interface, accepting the same columns as
+// Cell.Scan. Use NullVertex for columns that may be NULL.
+func (v *Vertex) Scan(src any) error {
+	return scanIndex(v, src, VertexMode, "Vertex.Scan")
+}
+
+// Value implements the driver.Valuer interface. The vertex is stored as an
+// int64.
+func (v Vertex) Value() (driver.Value, error) {
+	return int64(v), nil
+}
+
+// Scan implements the sql.Scanner interface.
+func (n *NullCell) Scan(src any) error {
+	return scanNull(&n.Cell, &n.Valid, src)
+}
+
+// Value implements the driver.Valuer interface.
+func (n NullCell) Value() (driver.Value, error) {
+	return nullValue(n.Cell, n.Valid)
+}
+
+// Scan implements the sql.Scanner interface.
+func (n *NullDirectedEdge) Scan(src any) error {
+	return scanNull(&n.DirectedEdge, &n.Valid, src)
+}
+
+// Value implements the driver.Valuer interface.
+func (n NullDirectedEdge) Value() (driver.Value, error) {
+	return nullValue(n.DirectedEdge, n.Valid)
+}
+
+// Scan implements the sql.Scanner interface.
+func (n *NullUndirectedEdge) Scan(src any) error {
+	return scanNull(&n.UndirectedEdge, &n.Valid, src)
+}
+
+// Value implements the driver.Valuer interface.
+func (n NullUndirectedEdge) Value() (driver.Value, error) {
+	return nullValue(n.UndirectedEdge, n.Valid)
+}
+
+// Scan implements the sql.Scanner interface.
+func (n *NullVertex) Scan(src any) error {
+	return scanNull(&n.Vertex, &n.Valid, src)
+}
+
+// Value implements the driver.Valuer interface.
+func (n NullVertex) Value() (driver.Value, error) {
+	return nullValue(n.Vertex, n.Valid)
+}
+
+func marshalBinary[I Index](index I) ([]byte, error) {
+	return binary.BigEndian.AppendUint64(make([]byte, 0, indexBinarySize), uint64(index)), nil
+}
+
+func unmarshalBinary[I validIndex](index *I, data []byte, mode IndexMode, op string) error {
+	if len(data) != indexBinarySize {
+		return indexErrorf(mode, op, "got %d bytes, want %d", len(data), indexBinarySize)
+	}
+
+	return setIndex(index, binary.BigEndian.Uint64(data), mode, op)
+}
+
+// scanIndex decodes a database value into index.
+func scanIndex[I validIndex](index *I, src any, mode IndexMode, op string) error {
+	var h uint64
+
+	switch src := src.(type) {
+	case int64:
+		h = uint64(src)
+	case []byte:
+		if len(src) == indexBinarySize {
+			h = binary.BigEndian.Uint64(src)
+			break
+		}
+
+		return scanIndex(index, string(src), mode, op)
+	case string:
+		parsed, err := parseIndexString[I](src, mode)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		h = uint64(parsed)
+	case nil:
+		return indexErrorf(mode, op, "cannot scan NULL, use a Null type")
+	default:
+		return indexErrorf(mode, op, "cannot scan %T", src)
+	}
+
+	return setIndex(index, h, mode, op)
+}
+
+// setIndex stores h in index if it is a valid index of the given mode.
+func setIndex[I validIndex](index *I, h uint64, mode IndexMode, op string) error {
+	found := IndexMode(h >> modeOffset & modeMask)
+	if found != mode || !I(h).IsValid() {
+		return toOpErr(invalidIndexError(mode), op, IndexToString(h), found)
+	}
+
+	*index = I(h)
+
+	return nil
+}
+
+// indexErrorf returns an error for op wrapping the sentinel error of the mode,
+// such as ErrCellInvalid.
+func indexErrorf(mode IndexMode, op, format string, args ...any) error {
+	return fmt.Errorf("%s: %s: %w", op, fmt.Sprintf(format, args...), toErr(invalidIndexError(mode)))
+}
+
+// invalidIndexError returns the H3 Core error code for an invalid index of the
+// mode.
+func invalidIndexError(mode IndexMode) C.uint32_t {
+	switch mode {
+	case CellMode:
+		return C.E_CELL_INVALID
+	case DirectedEdgeMode:
+		return C.E_DIR_EDGE_INVALID
+	case UndirectedEdgeMode:
+		return C.E_UNDIR_EDGE_INVALID
+	case VertexMode:
+		return C.E_VERTEX_INVALID
+	default:
+		return C.E_INDEX_INVALID
+	}
+}
+
+// scanNull scans src into index, or resets it if src is NULL, and records
+// whether the value was valid.
+func scanNull[T any, P interface {
+	*T
+	sql.Scanner
+}](index P, valid *bool, src any) error {
+	if src == nil {
+		var zero T
+		*index, *valid = zero, false
+
+		return nil
+	}
+
+	err := index.Scan(src)
+	*valid = err == nil
+
+	return err
+}
+
+func nullValue[I driver.Valuer](index I, valid bool) (driver.Value, error) {
+	if !valid {
+		return nil, nil //nolint:nilnil // NULL
+	}
+
+	return index.Value()
+}
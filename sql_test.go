@@ -0,0 +1,162 @@
+/*
+ * Copyright 2026 Uber Technologies, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *         http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package h3
+
+import (
+	"database/sql/driver"
+	"encoding/hex"
+	"testing"
+)
+
+func TestMarshalBinary(t *testing.T) {
+	t.Parallel()
+
+	data, err := validCell.MarshalBinary()
+	assertNoErr(t, err)
+	assertEqual(t, "0850dab63fffffff", hex.EncodeToString(data))
+
+	var c Cell
+	assertNoErr(t, c.UnmarshalBinary(data))
+	assertEqual(t, validCell, c)
+
+	data, err = validEdge.MarshalBinary()
+	assertNoErr(t, err)
+	var e DirectedEdge
+	assertNoErr(t, e.UnmarshalBinary(data))
+	assertEqual(t, validEdge, e)
+
+	// A directed edge is not a cell.
+	assertErrIs(t, c.UnmarshalBinary(data), ErrCellInvalid)
+	assertErrIs(t, c.UnmarshalBinary(data[:7]), ErrCellInvalid)
+	assertErrIs(t, c.UnmarshalBinary(nil), ErrCellInvalid)
+
+	undirected, err := validEdge.UndirectedEdge()
+	assertNoErr(t, err)
+	data, err = undirected.MarshalBinary()
+	assertNoErr(t, err)
+	var u UndirectedEdge
+	assertNoErr(t, u.UnmarshalBinary(data))
+	assertEqual(t, undirected, u)
+
+	data, err = validVertex.MarshalBinary()
+	assertNoErr(t, err)
+	var v Vertex
+	assertNoErr(t, v.UnmarshalBinary(data))
+	assertEqual(t, validVertex, v)
+	assertErrIs(t, e.UnmarshalBinary(data), ErrDirectedEdgeInvalid)
+}
+
+func TestScanValue(t *testing.T) {
+	t.Parallel()
+
+	binary, _ := validCell.MarshalBinary()
+	for _, src := range []any{
+		int64(validCell),
+		binary,
+		validCell.String(),
+		[]byte(validCell.String()),
+		"0x" + validCell.String(),
+	} {
+		var c Cell
+		assertNoErr(t, c.Scan(src))
+		assertEqual(t, validCell, c)
+	}
+
+	value, err := validCell.Value()
+	assertNoErr(t, err)
+	assertEqual(t, driver.Value(int64(validCell)), value)
+	assertTrue(t, driver.IsValue(value))
+
+	var c Cell
+	assertErrIs(t, c.Scan(int64(validEdge)), ErrCellInvalid)
+	assertErrIs(t, c.Scan(int64(-1)), ErrCellInvalid)
+	assertErrIs(t, c.Scan("not hex"), ErrCellInvalid)
+	assertErrIs(t, c.Scan(3.5), ErrCellInvalid)
+	assertErrIs(t, c.Scan(nil), ErrCellInvalid)
+	assertEqual(t, Cell(0), c)
+
+	var e DirectedEdge
+	assertNoErr(t, e.Scan(validEdge.String()))
+	assertEqual(t, validEdge, e)
+	assertErrIs(t, e.Scan(int64(validCell)), ErrDirectedEdgeInvalid)
+	assertErrIs(t, e.Scan([]byte{1, 2, 3}), ErrDirectedEdgeInvalid)
+	assertErrIs(t, e.Scan(true), ErrDirectedEdgeInvalid)
+
+	undirected, err := validEdge.UndirectedEdge()
+	assertNoErr(t, err)
+
+	var u UndirectedEdge
+	assertNoErr(t, u.Scan(int64(undirected)))
+	assertEqual(t, undirected, u)
+	assertErrIs(t, u.Scan(int64(validEdge)), ErrUndirectedEdgeInvalid)
+
+	var v Vertex
+	assertNoErr(t, v.Scan(int64(validVertex)))
+	assertEqual(t, validVertex, v)
+	assertErrIs(t, v.Scan(int64(validCell)), ErrVertexInvalid)
+
+	for _, valuer := range []driver.Valuer{validEdge, undirected, validVertex} {
+		value, err := valuer.Value()
+		assertNoErr(t, err)
+		assertTrue(t, driver.IsValue(value))
+	}
+}
+
+func TestNullIndex(t *testing.T) {
+	t.Parallel()
+
+	var n NullCell
+	assertNoErr(t, n.Scan(int64(validCell)))
+	assertEqual(t, NullCell{Cell: validCell, Valid: true}, n)
+
+	value, err := n.Value()
+	assertNoErr(t, err)
+	assertEqual(t, driver.Value(int64(validCell)), value)
+
+	assertNoErr(t, n.Scan(nil))
+	assertEqual(t, NullCell{}, n)
+
+	value, err = n.Value()
+	assertNoErr(t, err)
+	assertNil(t, value)
+
+	assertErrIs(t, n.Scan(int64(validEdge)), ErrCellInvalid)
+	assertFalse(t, n.Valid)
+
+	var ne NullDirectedEdge
+	assertNoErr(t, ne.Scan(validEdge.String()))
+	assertEqual(t, NullDirectedEdge{DirectedEdge: validEdge, Valid: true}, ne)
+	assertNoErr(t, ne.Scan(nil))
+	assertFalse(t, ne.Valid)
+
+	undirected, err := validEdge.UndirectedEdge()
+	assertNoErr(t, err)
+
+	var nu NullUndirectedEdge
+	assertNoErr(t, nu.Scan(int64(undirected)))
+	assertTrue(t, nu.Valid)
+	value, err = NullUndirectedEdge{}.Value()
+	assertNoErr(t, err)
+	assertNil(t, value)
+
+	var nv NullVertex
+	assertNoErr(t, nv.Scan(int64(validVertex)))
+	assertEqual(t, NullVertex{Vertex: validVertex, Valid: true}, nv)
+	value, err = nv.Value()
+	assertNoErr(t, err)
+	assertEqual(t, driver.Value(int64(validVertex)), value)
+}
@@ -0,0 +1,380 @@
+/*
+ * Copyright 2026 Uber Technologies, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *         http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package h3
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// ErrInvalidWKB is returned, wrapped with the offset and cause, when decoding
+// WKB that is malformed or of an unexpected type.
+var ErrInvalidWKB = errors.New("invalid WKB")
+
+// WKB byte orders, geometry types and EWKB flags.
+const (
+	wkbBigEndian    = 0
+	wkbLittleEndian = 1
+
+	wkbPointType        = 1
+	wkbPolygonType      = 3
+	wkbMultiPolygonType = 6
+
+	// ISO WKB adds 1000 to the geometry type for Z coordinates, 2000 for M
+	// and 3000 for both.
+	wkbISODims = 1000
+
+	ewkbZFlag    = 0x80000000
+	ewkbMFlag    = 0x40000000
+	ewkbSRIDFlag = 0x20000000
+)
+
+// WKB returns the point as little-endian ISO WKB.
+func (g LatLng) WKB() []byte {
+	return appendWKBPoint(nil, g, false)
+}
+
+// EWKB returns the point as little-endian EWKB with SRID 4326, as used by
+// PostGIS.
+func (g LatLng) EWKB() []byte {
+	return appendWKBPoint(nil, g, true)
+}
+
+// WKB returns the loop as a little-endian ISO WKB polygon with a single closed
+// ring.
+func (l GeoLoop) WKB() []byte {
+	return GeoPolygon{GeoLoop: l}.WKB()
+}
+
+// EWKB returns the loop as a little-endian EWKB polygon with SRID 4326 and a
+// single closed ring.
+func (l GeoLoop) EWKB() []byte {
+	return GeoPolygon{GeoLoop: l}.EWKB()
+}
+
+// WKB returns the polygon as little-endian ISO WKB. Rings are closed and keep
+// the winding order of the loops.
+func (p GeoPolygon) WKB() []byte {
+	return appendWKBPolygon(nil, p, false)
+}
+
+// EWKB returns the polygon as little-endian EWKB with SRID 4326.
+func (p GeoPolygon) EWKB() []byte {
+	return appendWKBPolygon(nil, p, true)
+}
+
+// MultiPolygonToWKB returns the polygons, such as the output of
+// CellsToMultiPolygon, as a little-endian ISO WKB multipolygon.
+func MultiPolygonToWKB(polygons []GeoPolygon) []byte {
+	return appendWKBMultiPolygon(nil, polygons, false)
+}
+
+// MultiPolygonToEWKB returns the polygons as a little-endian EWKB
+// multipolygon with SRID 4326.
+func MultiPolygonToEWKB(polygons []GeoPolygon) []byte {
+	return appendWKBMultiPolygon(nil, polygons, true)
+}
+
+// LatLngFromWKB decodes a WKB point in either byte order, as ISO WKB or as
+// EWKB. Z and M coordinates are ignored, and an EWKB SRID must be 4326.
+func LatLngFromWKB(data []byte) (LatLng, error) {
+	r := wkbReader{data: data}
+
+	typ, err := r.header()
+	if err != nil {
+		return LatLng{}, err
+	}
+	if typ != wkbPointType {
+		return LatLng{}, r.errorf("expected point, found geometry type %d", typ)
+	}
+
+	g, err := r.coord()
+	if err != nil {
+		return LatLng{}, err
+	}
+	if math.IsNaN(g.Lat) && math.IsNaN(g.Lng) {
+		return LatLng{}, r.errorf("empty point")
+	}
+
+	return g, r.end()
+}
+
+// GeoPolygonsFromWKB decodes a WKB polygon or multipolygon in either byte
+// order, as ISO WKB or as EWKB. Rings become loops as described on
+// GeoPolygon. Empty polygons are dropped, Z and M coordinates are ignored, and
+// an EWKB SRID must be 4326.
+func GeoPolygonsFromWKB(data []byte) ([]GeoPolygon, error) {
+	r := wkbReader{data: data}
+
+	typ, err := r.header()
+	if err != nil {
+		return nil, err
+	}
+
+	var out []GeoPolygon
+	switch typ {
+	case wkbPolygonType:
+		polygon, err := r.polygon()
+		if err != nil {
+			return nil, err
+		}
+		if len(polygon.GeoLoop) > 0 {
+			out = append(out, polygon)
+		}
+
+	case wkbMultiPolygonType:
+		n, err := r.count(wkbHeaderSize)
+		if err != nil {
+			return nil, err
+		}
+
+		for range n {
+			typ, err := r.header()
+			if err != nil {
+				return nil, err
+			}
+			if typ != wkbPolygonType {
+				return nil, r.errorf("expected polygon in multipolygon, found geometry type %d", typ)
+			}
+
+			polygon, err := r.polygon()
+			if err != nil {
+				return nil, err
+			}
+			if len(polygon.GeoLoop) > 0 {
+				out = append(out, polygon)
+			}
+		}
+
+	default:
+		return nil, r.errorf("expected polygon or multipolygon, found geometry type %d", typ)
+	}
+
+	return out, r.end()
+}
+
+func appendWKBHeader(b []byte, typ uint32, srid bool) []byte {
+	b = append(b, wkbLittleEndian)
+	if !srid {
+		return binary.LittleEndian.AppendUint32(b, typ)
+	}
+
+	b = binary.LittleEndian.AppendUint32(b, typ|ewkbSRIDFlag)
+
+	return binary.LittleEndian.AppendUint32(b, SRIDWGS84)
+}
+
+func appendWKBCoord(b []byte, g LatLng) []byte {
+	b = binary.LittleEndian.AppendUint64(b, math.Float64bits(g.Lng))
+	return binary.LittleEndian.AppendUint64(b, math.Float64bits(g.Lat))
+}
+
+func appendWKBPoint(b []byte, g LatLng, srid bool) []byte {
+	return appendWKBCoord(appendWKBHeader(b, wkbPointType, srid), g)
+}
+
+func appendWKBPolygon(b []byte, p GeoPolygon, srid bool) []byte {
+	b = appendWKBHeader(b, wkbPolygonType, srid)
+	if len(p.GeoLoop) == 0 {
+		return binary.LittleEndian.AppendUint32(b, 0)
+	}
+
+	b = binary.LittleEndian.AppendUint32(b, uint32(1+len(p.Holes)))
+	for _, loop := range append([]GeoLoop{p.GeoLoop}, p.Holes...) {
+		ring := closeLoop(loop)
+		b = binary.LittleEndian.AppendUint32(b, uint32(len(ring)))
+		for _, g := range ring {
+			b = appendWKBCoord(b, g)
+		}
+	}
+
+	return b
+}
+
+func appendWKBMultiPolygon(b []byte, polygons []GeoPolygon, srid bool) []byte {
+	b = appendWKBHeader(b, wkbMultiPolygonType, srid)
+	b = binary.LittleEndian.AppendUint32(b, uint32(len(polygons)))
+	for _, p := range polygons {
+		// Only the outermost geometry carries the SRID.
+		b = appendWKBPolygon(b, p, false)
+	}
+
+	return b
+}
+
+// wkbHeaderSize is the smallest size of a geometry header: the byte order and
+// the geometry type.
+const wkbHeaderSize = 5
+
+// wkbReader decodes WKB from data, tracking the offset for errors.
+type wkbReader struct {
+	data  []byte
+	pos   int
+	order binary.ByteOrder
+	// dims is the number of values per coordinate of the current geometry.
+	dims int
+}
+
+func (r *wkbReader) errorf(format string, args ...any) error {
+	return fmt.Errorf("%w: offset %d: %s", ErrInvalidWKB, r.pos, fmt.Sprintf(format, args...))
+}
+
+func (r *wkbReader) uint32() (uint32, error) {
+	if len(r.data)-r.pos < 4 { //nolint:mnd // size of uint32
+		return 0, r.errorf("unexpected end of input")
+	}
+
+	v := r.order.Uint32(r.data[r.pos:])
+	r.pos += 4
+
+	return v, nil
+}
+
+// count reads the number of elements that follow, each at least size bytes
+// long, checking that they fit in the remaining input.
+func (r *wkbReader) count(size int) (int, error) {
+	n, err := r.uint32()
+	if err != nil {
+		return 0, err
+	}
+	if uint64(n)*uint64(size) > uint64(len(r.data)-r.pos) {
+		r.pos -= 4
+		return 0, r.errorf("count %d exceeds the remaining %d bytes", n, len(r.data)-r.pos-4)
+	}
+
+	return int(n), nil
+}
+
+// header reads a byte order, geometry type and optional EWKB SRID, and
+// returns the base geometry type.
+func (r *wkbReader) header() (uint32, error) {
+	if r.pos >= len(r.data) {
+		return 0, r.errorf("unexpected end of input")
+	}
+
+	switch r.data[r.pos] {
+	case wkbBigEndian:
+		r.order = binary.BigEndian
+	case wkbLittleEndian:
+		r.order = binary.LittleEndian
+	default:
+		return 0, r.errorf("invalid byte order %d", r.data[r.pos])
+	}
+	r.pos++
+
+	start := r.pos
+
+	typ, err := r.uint32()
+	if err != nil {
+		return 0, err
+	}
+
+	r.dims = 2
+	if typ&ewkbZFlag != 0 {
+		r.dims++
+	}
+	if typ&ewkbMFlag != 0 {
+		r.dims++
+	}
+
+	if typ&ewkbSRIDFlag != 0 {
+		srid, err := r.uint32()
+		if err != nil {
+			return 0, err
+		}
+		if srid != SRIDWGS84 {
+			r.pos -= 4
+			return 0, r.errorf("unsupported SRID %d, want %d", srid, SRIDWGS84)
+		}
+	}
+
+	typ &^= ewkbZFlag | ewkbMFlag | ewkbSRIDFlag
+	switch typ / wkbISODims {
+	case 0:
+	case 1, 2: //nolint:mnd // Z or M
+		r.dims++
+	case 3: //nolint:mnd // Z and M
+		r.dims += 2
+	default:
+		r.pos = start
+		return 0, r.errorf("invalid geometry type %d", typ)
+	}
+
+	return typ % wkbISODims, nil
+}
+
+// coord reads a longitude and latitude, skipping any Z and M values.
+func (r *wkbReader) coord() (LatLng, error) {
+	size := 8 * r.dims
+	if len(r.data)-r.pos < size {
+		return LatLng{}, r.errorf("unexpected end of input")
+	}
+
+	lng := math.Float64frombits(r.order.Uint64(r.data[r.pos:]))
+	lat := math.Float64frombits(r.order.Uint64(r.data[r.pos+8:]))
+	r.pos += size
+
+	return LatLng{Lat: lat, Lng: lng}, nil
+}
+
+func (r *wkbReader) polygon() (GeoPolygon, error) {
+	rings, err := r.count(4) //nolint:mnd // size of the point count
+	if err != nil {
+		return GeoPolygon{}, err
+	}
+
+	var polygon GeoPolygon
+	for i := range rings {
+		start := r.pos
+
+		n, err := r.count(8 * r.dims)
+		if err != nil {
+			return GeoPolygon{}, err
+		}
+
+		ring := make(GeoLoop, n)
+		for j := range ring {
+			if ring[j], err = r.coord(); err != nil {
+				return GeoPolygon{}, err
+			}
+		}
+
+		ring, problem := openRing(ring)
+		if problem != "" {
+			r.pos = start
+			return GeoPolygon{}, r.errorf("%s", problem)
+		}
+
+		if i == 0 {
+			polygon.GeoLoop = ring
+		} else {
+			polygon.Holes = append(polygon.Holes, ring)
+		}
+	}
+
+	return polygon, nil
+}
+
+func (r *wkbReader) end() error {
+	if r.pos < len(r.data) {
+		return r.errorf("%d unexpected bytes after geometry", len(r.data)-r.pos)
+	}
+
+	return nil
+}
@@ -0,0 +1,122 @@
+/*
+ * Copyright 2026 Uber Technologies, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *         http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package h3
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+func TestLatLngWKB(t *testing.T) {
+	t.Parallel()
+
+	g := NewLatLng(2, 1)
+	assertEqual(t, "0101000000000000000000f03f0000000000000040", hex.EncodeToString(g.WKB()))
+	assertEqual(t, "0101000020e6100000000000000000f03f0000000000000040", hex.EncodeToString(g.EWKB()))
+
+	for _, s := range []string{
+		"0101000000000000000000f03f0000000000000040",
+		"0101000020e6100000000000000000f03f0000000000000040",
+		"00000000013ff00000000000004000000000000000",
+		// ISO POINT Z and EWKB POINT ZM.
+		"01e9030000000000000000f03f00000000000000400000000000000840",
+		"01010000e0e6100000000000000000f03f000000000000004000000000000008400000000000001040",
+	} {
+		data, _ := hex.DecodeString(s)
+		parsed, err := LatLngFromWKB(data)
+		assertNoErr(t, err)
+		assertEqual(t, g, parsed)
+	}
+
+	for _, tc := range []struct{ s, msg string }{
+		{"", "offset 0: unexpected end of input"},
+		{"02", "offset 0: invalid byte order 2"},
+		{"01010000", "offset 1: unexpected end of input"},
+		{"0101000000000000000000f03f", "offset 5: unexpected end of input"},
+		{"0101000000000000000000f03f000000000000004000", "offset 21: 1 unexpected bytes after geometry"},
+		{"0101000020110f0000000000000000f03f0000000000000040", "offset 5: unsupported SRID 3857, want 4326"},
+		{"0103000000", "expected point, found geometry type 3"},
+		{"01a10f0000", "offset 1: invalid geometry type 4001"},
+		{"0101000000000000000000f87f000000000000f87f", "empty point"},
+	} {
+		data, _ := hex.DecodeString(tc.s)
+		_, err := LatLngFromWKB(data)
+		assertErrIs(t, err, ErrInvalidWKB)
+		if !strings.Contains(err.Error(), tc.msg) {
+			t.Errorf("%s: error %q does not contain %q", tc.s, err, tc.msg)
+		}
+	}
+}
+
+func TestGeoPolygonWKB(t *testing.T) {
+	t.Parallel()
+
+	for _, data := range [][]byte{validGeoPolygonHoles.WKB(), validGeoPolygonHoles.EWKB()} {
+		polygons, err := GeoPolygonsFromWKB(data)
+		assertNoErr(t, err)
+		assertEqual(t, 1, len(polygons))
+		assertEqualGeoPolygon(t, validGeoPolygonHoles, polygons[0])
+	}
+
+	polygons, err := GeoPolygonsFromWKB(validGeoLoop.EWKB())
+	assertNoErr(t, err)
+	assertEqual(t, 1, len(polygons))
+	assertEqualLatLngs(t, validGeoLoop, polygons[0].GeoLoop)
+
+	disk, err := validCell.GridDisk(1)
+	assertNoErr(t, err)
+	multi, err := CellsToMultiPolygon(append(disk, pentagonCell))
+	assertNoErr(t, err)
+
+	for _, data := range [][]byte{MultiPolygonToWKB(multi), MultiPolygonToEWKB(multi)} {
+		polygons, err := GeoPolygonsFromWKB(data)
+		assertNoErr(t, err)
+		assertEqual(t, len(multi), len(polygons))
+		for i := range multi {
+			assertEqualGeoPolygon(t, multi[i], polygons[i])
+		}
+	}
+
+	polygons, err = GeoPolygonsFromWKB(GeoPolygon{}.WKB())
+	assertNoErr(t, err)
+	assertEqual(t, 0, len(polygons))
+
+	square := GeoLoop{{Lat: 0, Lng: 0}, {Lat: 0, Lng: 1}, {Lat: 1, Lng: 1}, {Lat: 1, Lng: 0}}
+	open := square.WKB()
+	// Change the last point of the ring so it is no longer closed.
+	open[len(open)-1] = 0x3f
+	short := GeoLoop(square[:2]).WKB()
+
+	for _, tc := range []struct {
+		data []byte
+		msg  string
+	}{
+		{open, "offset 9: ring is not closed"},
+		{short, "offset 9: ring has 3 points, need at least 4"},
+		{square.WKB()[:20], "offset 9: count 5 exceeds the remaining 7 bytes"},
+		{MultiPolygonToWKB([]GeoPolygon{{GeoLoop: square}})[:20], "offset 14: count 1 exceeds the remaining 2 bytes"},
+		{append(MultiPolygonToWKB(nil)[:5], 1, 0, 0, 0, 1, 1, 0, 0, 0), "expected polygon in multipolygon, found geometry type 1"},
+		{NewLatLng(1, 2).WKB(), "expected polygon or multipolygon, found geometry type 1"},
+	} {
+		_, err := GeoPolygonsFromWKB(tc.data)
+		assertErrIs(t, err, ErrInvalidWKB)
+		if !strings.Contains(err.Error(), tc.msg) {
+			t.Errorf("%x: error %q does not contain %q", tc.data, err, tc.msg)
+		}
+	}
+}
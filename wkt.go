@@ -0,0 +1,475 @@
+/*
+ * Copyright 2026 Uber Technologies, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *         http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package h3
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// SRIDWGS84 is the spatial reference identifier of WGS 84 longitude and
+// latitude, the only one accepted in EWKT and EWKB input.
+const SRIDWGS84 = 4326
+
+// ErrInvalidWKT is returned, wrapped with the offset and cause, when parsing
+// WKT that is malformed or of an unexpected type.
+var ErrInvalidWKT = errors.New("invalid WKT")
+
+// Geometry type names in WKT.
+const (
+	wktPoint        = "POINT"
+	wktPolygon      = "POLYGON"
+	wktMultiPolygon = "MULTIPOLYGON"
+	wktEmpty        = "EMPTY"
+)
+
+// WKT returns the point as a WKT POINT, with the longitude first.
+func (g LatLng) WKT() string {
+	var b strings.Builder
+
+	b.WriteString(wktPoint + " (")
+	writeWKTCoord(&b, g)
+	b.WriteByte(')')
+
+	return b.String()
+}
+
+// WKT returns the loop as a WKT POLYGON with a single closed ring.
+func (l GeoLoop) WKT() string {
+	return GeoPolygon{GeoLoop: l}.WKT()
+}
+
+// WKT returns the polygon as a WKT POLYGON. Rings are closed and keep the
+// winding order of the loops.
+func (p GeoPolygon) WKT() string {
+	if len(p.GeoLoop) == 0 {
+		return wktPolygon + " " + wktEmpty
+	}
+
+	var b strings.Builder
+
+	b.WriteString(wktPolygon + " ")
+	writeWKTPolygon(&b, p)
+
+	return b.String()
+}
+
+// MultiPolygonToWKT returns the polygons, such as the output of
+// CellsToMultiPolygon, as a WKT MULTIPOLYGON.
+func MultiPolygonToWKT(polygons []GeoPolygon) string {
+	if len(polygons) == 0 {
+		return wktMultiPolygon + " " + wktEmpty
+	}
+
+	var b strings.Builder
+
+	b.WriteString(wktMultiPolygon + " (")
+	for i, p := range polygons {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		writeWKTPolygon(&b, p)
+	}
+	b.WriteByte(')')
+
+	return b.String()
+}
+
+// LatLngFromWKT parses a WKT or EWKT POINT. Z and M coordinates are ignored.
+func LatLngFromWKT(s string) (LatLng, error) {
+	p := wktParser{s: s}
+
+	typ, err := p.header()
+	if err != nil {
+		return LatLng{}, err
+	}
+	if typ != wktPoint {
+		return LatLng{}, p.errorf("expected %s, found %s", wktPoint, typ)
+	}
+
+	if p.empty() {
+		return LatLng{}, p.errorf("%s %s has no coordinates", wktPoint, wktEmpty)
+	}
+
+	if err := p.expect('('); err != nil {
+		return LatLng{}, err
+	}
+	g, err := p.coord()
+	if err != nil {
+		return LatLng{}, err
+	}
+	if err := p.expect(')'); err != nil {
+		return LatLng{}, err
+	}
+
+	return g, p.end()
+}
+
+// GeoPolygonsFromWKT parses a WKT or EWKT POLYGON or MULTIPOLYGON, converting
+// rings to loops as described on GeoPolygon. EMPTY geometries produce no
+// polygons, and Z and M coordinates are ignored.
+func GeoPolygonsFromWKT(s string) ([]GeoPolygon, error) {
+	p := wktParser{s: s}
+
+	typ, err := p.header()
+	if err != nil {
+		return nil, err
+	}
+	if typ != wktPolygon && typ != wktMultiPolygon {
+		return nil, p.errorf("expected %s or %s, found %s", wktPolygon, wktMultiPolygon, typ)
+	}
+
+	if p.empty() {
+		return nil, p.end()
+	}
+
+	var out []GeoPolygon
+	if typ == wktPolygon {
+		polygon, err := p.polygon()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, polygon)
+	} else {
+		if err := p.expect('('); err != nil {
+			return nil, err
+		}
+		for {
+			if !p.empty() {
+				polygon, err := p.polygon()
+				if err != nil {
+					return nil, err
+				}
+				out = append(out, polygon)
+			}
+			if !p.accept(',') {
+				break
+			}
+		}
+		if err := p.expect(')'); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, p.end()
+}
+
+func writeWKTCoord(b *strings.Builder, g LatLng) {
+	b.WriteString(strconv.FormatFloat(g.Lng, 'f', -1, 64))
+	b.WriteByte(' ')
+	b.WriteString(strconv.FormatFloat(g.Lat, 'f', -1, 64))
+}
+
+func writeWKTPolygon(b *strings.Builder, p GeoPolygon) {
+	b.WriteByte('(')
+	for i, loop := range append([]GeoLoop{p.GeoLoop}, p.Holes...) {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+
+		b.WriteByte('(')
+		for j, g := range closeLoop(loop) {
+			if j > 0 {
+				b.WriteString(", ")
+			}
+			writeWKTCoord(b, g)
+		}
+		b.WriteByte(')')
+	}
+	b.WriteByte(')')
+}
+
+// closeLoop returns the loop with its first vertex repeated at the end, unless
+// it already is or the loop is empty.
+func closeLoop(l GeoLoop) GeoLoop {
+	if len(l) == 0 || l[0] == l[len(l)-1] {
+		return l
+	}
+
+	return append(l[:len(l):len(l)], l[0])
+}
+
+// openRing checks that a ring read from WKT or WKB is closed and has enough
+// vertexes, and returns it without the repeated vertex. It returns a
+// description of the problem otherwise.
+func openRing(ring GeoLoop) (GeoLoop, string) {
+	// A closed ring has at least 4 vertexes, the last equal to the first.
+	if len(ring) < 4 { //nolint:mnd // see above
+		return nil, fmt.Sprintf("ring has %d points, need at least 4", len(ring))
+	}
+	if ring[0] != ring[len(ring)-1] {
+		return nil, "ring is not closed"
+	}
+
+	return ring[:len(ring)-1], ""
+}
+
+// wktParser reads WKT from s, tracking the byte offset for errors.
+type wktParser struct {
+	s   string
+	pos int
+	// dims is the number of values per coordinate declared by the Z and M
+	// modifiers, or 0 if none were given.
+	dims int
+}
+
+func (p *wktParser) errorf(format string, args ...any) error {
+	return fmt.Errorf("%w: offset %d: %s", ErrInvalidWKT, p.pos, fmt.Sprintf(format, args...))
+}
+
+func (p *wktParser) skipSpace() {
+	for p.pos < len(p.s) && strings.IndexByte(" \t\r\n", p.s[p.pos]) >= 0 {
+		p.pos++
+	}
+}
+
+// found describes the next token for errors.
+func (p *wktParser) found() string {
+	if p.pos >= len(p.s) {
+		return "end of input"
+	}
+
+	return strconv.Quote(p.s[p.pos : p.pos+1])
+}
+
+// word reads a keyword and returns it in upper case, or "" if there is none.
+func (p *wktParser) word() string {
+	p.skipSpace()
+
+	start := p.pos
+	for p.pos < len(p.s) && (p.s[p.pos]|0x20 >= 'a' && p.s[p.pos]|0x20 <= 'z') {
+		p.pos++
+	}
+
+	return strings.ToUpper(p.s[start:p.pos])
+}
+
+// accept consumes c if it is the next token.
+func (p *wktParser) accept(c byte) bool {
+	p.skipSpace()
+	if p.pos < len(p.s) && p.s[p.pos] == c {
+		p.pos++
+		return true
+	}
+
+	return false
+}
+
+func (p *wktParser) expect(c byte) error {
+	if !p.accept(c) {
+		return p.errorf("expected %q, found %s", c, p.found())
+	}
+
+	return nil
+}
+
+// empty consumes the EMPTY keyword if it is next.
+func (p *wktParser) empty() bool {
+	start := p.pos
+	if p.word() == wktEmpty {
+		return true
+	}
+	p.pos = start
+
+	return false
+}
+
+func (p *wktParser) end() error {
+	p.skipSpace()
+	if p.pos < len(p.s) {
+		return p.errorf("unexpected %s after geometry", p.found())
+	}
+
+	return nil
+}
+
+// header reads the optional EWKT SRID, the geometry type and its dimension
+// modifiers, and returns the type.
+func (p *wktParser) header() (string, error) {
+	p.skipSpace()
+
+	if len(p.s)-p.pos >= 5 && strings.EqualFold(p.s[p.pos:p.pos+5], "SRID=") {
+		p.pos += 5
+
+		start := p.pos
+		end := strings.IndexByte(p.s[start:], ';')
+		if end < 0 {
+			return "", p.errorf("expected ';' after SRID")
+		}
+
+		srid, err := strconv.Atoi(strings.TrimSpace(p.s[start : start+end]))
+		if err != nil {
+			return "", p.errorf("invalid SRID %q", p.s[start:start+end])
+		}
+		if srid != SRIDWGS84 {
+			return "", p.errorf("unsupported SRID %d, want %d", srid, SRIDWGS84)
+		}
+		p.pos = start + end + 1
+	}
+
+	typ := p.word()
+	if typ == "" {
+		return "", p.errorf("expected geometry type, found %s", p.found())
+	}
+
+	// The modifier may be separate, as in "POINT Z", or attached, as in
+	// "POINTZ".
+	modifier := ""
+	for _, base := range []string{wktMultiPolygon, wktPolygon, wktPoint} {
+		if strings.HasPrefix(typ, base) {
+			modifier = typ[len(base):]
+			typ = base
+
+			break
+		}
+	}
+	if modifier == "" {
+		start := p.pos
+		modifier = p.word()
+		if modifier == wktEmpty {
+			p.pos = start
+			modifier = ""
+		}
+	}
+
+	switch modifier {
+	case "":
+	case "Z", "M":
+		p.dims = 3
+	case "ZM":
+		p.dims = 4
+	default:
+		return "", p.errorf("unknown dimension %q", modifier)
+	}
+
+	switch typ {
+	case wktPoint, wktPolygon, wktMultiPolygon:
+		return typ, nil
+	default:
+		return "", p.errorf("unsupported geometry type %s", typ)
+	}
+}
+
+func (p *wktParser) number() (float64, error) {
+	p.skipSpace()
+
+	start := p.pos
+	for p.pos < len(p.s) && strings.IndexByte("0123456789+-.eE", p.s[p.pos]) >= 0 {
+		p.pos++
+	}
+	if start == p.pos {
+		return 0, p.errorf("expected number, found %s", p.found())
+	}
+
+	text := p.s[start:p.pos]
+	v, err := strconv.ParseFloat(text, 64)
+	if err != nil {
+		p.pos = start
+		return 0, p.errorf("invalid number %q", text)
+	}
+
+	return v, nil
+}
+
+// coord reads a longitude and latitude, followed by any Z and M values.
+func (p *wktParser) coord() (LatLng, error) {
+	var values []float64
+	for {
+		v, err := p.number()
+		if err != nil {
+			return LatLng{}, err
+		}
+		values = append(values, v)
+
+		p.skipSpace()
+		if p.pos >= len(p.s) || p.s[p.pos] == ',' || p.s[p.pos] == ')' {
+			break
+		}
+	}
+
+	want := p.dims
+	if want == 0 && len(values) >= 2 && len(values) <= 4 {
+		want = len(values)
+	}
+	if len(values) != want {
+		return LatLng{}, p.errorf("coordinate has %d values, want %d", len(values), max(want, 2))
+	}
+
+	return LatLng{Lat: values[1], Lng: values[0]}, nil
+}
+
+func (p *wktParser) ring() (GeoLoop, error) {
+	p.skipSpace()
+	start := p.pos
+
+	if err := p.expect('('); err != nil {
+		return nil, err
+	}
+
+	var ring GeoLoop
+	for {
+		g, err := p.coord()
+		if err != nil {
+			return nil, err
+		}
+		ring = append(ring, g)
+
+		if !p.accept(',') {
+			break
+		}
+	}
+
+	if err := p.expect(')'); err != nil {
+		return nil, err
+	}
+
+	ring, problem := openRing(ring)
+	if problem != "" {
+		p.pos = start
+		return nil, p.errorf("%s", problem)
+	}
+
+	return ring, nil
+}
+
+func (p *wktParser) polygon() (GeoPolygon, error) {
+	if err := p.expect('('); err != nil {
+		return GeoPolygon{}, err
+	}
+
+	var polygon GeoPolygon
+	for i := 0; ; i++ {
+		ring, err := p.ring()
+		if err != nil {
+			return GeoPolygon{}, err
+		}
+
+		if i == 0 {
+			polygon.GeoLoop = ring
+		} else {
+			polygon.Holes = append(polygon.Holes, ring)
+		}
+
+		if !p.accept(',') {
+			break
+		}
+	}
+
+	return polygon, p.expect(')')
+}
@@ -0,0 +1,133 @@
+/*
+ * Copyright 2026 Uber Technologies, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *         http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package h3
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLatLngWKT(t *testing.T) {
+	t.Parallel()
+
+	g := NewLatLng(37.5, -122.25)
+	assertEqual(t, "POINT (-122.25 37.5)", g.WKT())
+
+	for _, s := range []string{
+		g.WKT(),
+		"point(-122.25 37.5)",
+		"SRID=4326;POINT(-122.25 37.5)",
+		"POINT Z (-122.25 37.5 10)",
+		"POINTZM(-122.25 37.5 10 20)",
+		"POINT (-122.25 37.5 10)",
+		"  POINT ( -1.2225e2  3.75E1 )  ",
+	} {
+		parsed, err := LatLngFromWKT(s)
+		assertNoErr(t, err)
+		assertEqual(t, g, parsed)
+	}
+
+	for _, tc := range []struct{ s, msg string }{
+		{"", "offset 0: expected geometry type"},
+		{"POINT EMPTY", "has no coordinates"},
+		{"POINT (1)", "offset 8: coordinate has 1 values, want 2"},
+		{"POINT Z (1 2)", "coordinate has 2 values, want 3"},
+		{"POINT (1 x)", "offset 9: expected number"},
+		{"POINT (1 2", "offset 10: expected ')', found end of input"},
+		{"POINT (1 2) x", "offset 12: unexpected \"x\" after geometry"},
+		{"POINT (1 --2)", "invalid number \"--2\""},
+		{"POLYGON EMPTY", "expected POINT, found POLYGON"},
+		{"LINESTRING (1 2, 3 4)", "unsupported geometry type LINESTRING"},
+		{"POINT Q (1 2)", "unknown dimension \"Q\""},
+		{"SRID=3857;POINT (1 2)", "unsupported SRID 3857, want 4326"},
+		{"SRID=4326 POINT (1 2)", "expected ';' after SRID"},
+	} {
+		_, err := LatLngFromWKT(tc.s)
+		assertErrIs(t, err, ErrInvalidWKT)
+		if !strings.Contains(err.Error(), tc.msg) {
+			t.Errorf("%q: error %q does not contain %q", tc.s, err, tc.msg)
+		}
+	}
+}
+
+func TestGeoPolygonWKT(t *testing.T) {
+	t.Parallel()
+
+	square := GeoLoop{{Lat: 0, Lng: 0}, {Lat: 0, Lng: 1}, {Lat: 1, Lng: 1}, {Lat: 1, Lng: 0}}
+	assertEqual(t, "POLYGON ((0 0, 1 0, 1 1, 0 1, 0 0))", square.WKT())
+	assertEqual(t, "POLYGON EMPTY", GeoPolygon{}.WKT())
+	assertEqual(t, "MULTIPOLYGON EMPTY", MultiPolygonToWKT(nil))
+
+	polygons, err := GeoPolygonsFromWKT(validGeoPolygonHoles.WKT())
+	assertNoErr(t, err)
+	assertEqual(t, 1, len(polygons))
+	assertEqualGeoPolygon(t, validGeoPolygonHoles, polygons[0])
+
+	cells, err := PolygonToCells(polygons[0], 9)
+	assertNoErr(t, err)
+	expected, err := PolygonToCells(validGeoPolygonHoles, 9)
+	assertNoErr(t, err)
+	assertEqualCells(t, expected, cells)
+
+	disk, err := validCell.GridDisk(1)
+	assertNoErr(t, err)
+	multi, err := CellsToMultiPolygon(append(disk, pentagonCell))
+	assertNoErr(t, err)
+
+	s := MultiPolygonToWKT(multi)
+	assertTrue(t, strings.HasPrefix(s, "MULTIPOLYGON ((("))
+	polygons, err = GeoPolygonsFromWKT("SRID=4326;" + s)
+	assertNoErr(t, err)
+	assertEqual(t, len(multi), len(polygons))
+	for i := range multi {
+		assertEqualGeoPolygon(t, multi[i], polygons[i])
+	}
+
+	polygons, err = GeoPolygonsFromWKT("MULTIPOLYGON (EMPTY, ((0 0, 1 0, 1 1, 0 0)))")
+	assertNoErr(t, err)
+	assertEqual(t, 1, len(polygons))
+	assertEqual(t, 3, len(polygons[0].GeoLoop))
+
+	polygons, err = GeoPolygonsFromWKT("POLYGON EMPTY")
+	assertNoErr(t, err)
+	assertEqual(t, 0, len(polygons))
+
+	for _, tc := range []struct{ s, msg string }{
+		{"POLYGON ((0 0, 1 0, 0 0))", "offset 9: ring has 3 points, need at least 4"},
+		{"POLYGON ((0 0, 1 0, 1 1, 0 1))", "offset 9: ring is not closed"},
+		{"POLYGON (0 0, 1 0, 1 1, 0 0)", "offset 9: expected '(', found \"0\""},
+		{"POLYGON ((0 0, 1 0, 1 1, 0 0)", "expected ')', found end of input"},
+		{"MULTIPOLYGON ((0 0, 1 0, 1 1, 0 0))", "offset 15: expected '(', found \"0\""},
+		{"POINT (1 2)", "expected POLYGON or MULTIPOLYGON, found POINT"},
+	} {
+		_, err := GeoPolygonsFromWKT(tc.s)
+		assertErrIs(t, err, ErrInvalidWKT)
+		if !strings.Contains(err.Error(), tc.msg) {
+			t.Errorf("%q: error %q does not contain %q", tc.s, err, tc.msg)
+		}
+	}
+}
+
+func assertEqualGeoPolygon(t *testing.T, expected, actual GeoPolygon) {
+	t.Helper()
+
+	assertEqualLatLngs(t, expected.GeoLoop, actual.GeoLoop)
+	assertEqual(t, len(expected.Holes), len(actual.Holes))
+	for i := range expected.Holes {
+		assertEqualLatLngs(t, expected.Holes[i], actual.Holes[i])
+	}
+}